@@ -31,3 +31,24 @@ const (
 	// TypeMortgage DEPRECATED identifies a mortgage account
 	TypeMortgage Type = "mortgage"
 )
+
+// Valid reports whether the type is one of the known account types,
+// deprecated ones included
+func (t Type) Valid() bool {
+	switch t {
+	case TypeChecking, TypeSavings, TypeCash, TypeCreditCard, TypeLineOfCredit,
+		TypeOtherAsset, TypeOtherLiability:
+		return true
+	}
+	return t.Deprecated()
+}
+
+// Deprecated reports whether the type is no longer supported for new
+// accounts
+func (t Type) Deprecated() bool {
+	switch t {
+	case TypePayPal, TypeMerchant, TypeInvestment, TypeMortgage:
+		return true
+	}
+	return false
+}
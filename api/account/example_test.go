@@ -11,6 +11,7 @@ import (
 
 	"github.com/mellis/ynab.go"
 	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/account"
 )
 
 func ExampleService_GetAccount() {
@@ -29,3 +30,16 @@ func ExampleService_GetAccounts() {
 
 	// Output: *account.SearchResultSnapshot
 }
+
+func ExampleService_CreateAccount() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p := account.PayloadAccount{
+		Name:    "Savings",
+		Type:    account.TypeSavings,
+		Balance: 100000,
+	}
+	a, _ := c.Account().CreateAccount(context.Background(), "<valid_budget_id>", p)
+	fmt.Println(reflect.TypeOf(a))
+
+	// Output: *account.Account
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package account
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	errInvalidName = errors.New("account: invalid account name")
+	errInvalidType = errors.New("account: invalid account type")
+)
+
+// PayloadAccount is the payload contract for creating an account
+type PayloadAccount struct {
+	Name string `json:"name"`
+	Type Type   `json:"type"`
+	// Balance The current balance of the account in milliunits format
+	Balance int64 `json:"balance"`
+}
+
+// Validate checks the account has a name and a type supported for new
+// accounts, deprecated types being rejected
+func (p PayloadAccount) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errInvalidName
+	}
+	if !p.Type.Valid() {
+		return fmt.Errorf("%w: %q", errInvalidType, p.Type)
+	}
+	if p.Type.Deprecated() {
+		return fmt.Errorf("%w: %q is deprecated", errInvalidType, p.Type)
+	}
+	return nil
+}
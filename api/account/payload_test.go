@@ -0,0 +1,61 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package account_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api/account"
+)
+
+func TestPayloadAccount_Validate(t *testing.T) {
+	table := []struct {
+		Name    string
+		Payload account.PayloadAccount
+		Err     string
+	}{
+		{
+			Name:    "valid",
+			Payload: account.PayloadAccount{Name: "Savings", Type: account.TypeSavings, Balance: 100000},
+		},
+		{
+			Name:    "valid liability",
+			Payload: account.PayloadAccount{Name: "Car loan", Type: account.TypeOtherLiability, Balance: -500000},
+		},
+		{
+			Name:    "missing name",
+			Payload: account.PayloadAccount{Name: " ", Type: account.TypeChecking},
+			Err:     "account: invalid account name",
+		},
+		{
+			Name:    "unknown type",
+			Payload: account.PayloadAccount{Name: "Savings", Type: "piggyBank"},
+			Err:     `account: invalid account type: "piggyBank"`,
+		},
+		{
+			Name:    "deprecated type",
+			Payload: account.PayloadAccount{Name: "PayPal", Type: account.TypePayPal},
+			Err:     `account: invalid account type: "payPal" is deprecated`,
+		},
+		{
+			Name:    "deprecated merchant type",
+			Payload: account.PayloadAccount{Name: "Shop", Type: account.TypeMerchant},
+			Err:     `account: invalid account type: "merchantAccount" is deprecated`,
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Payload.Validate()
+			if test.Err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.Err)
+		})
+	}
+}
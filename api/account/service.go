@@ -6,19 +6,20 @@ package account
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/mellis/ynab.go/api"
 )
 
 // NewService facilitates the creation of a new account service instance
-func NewService(c api.ClientReader) *Service {
+func NewService(c api.ClientReaderWriter) *Service {
 	return &Service{c}
 }
 
 // Service wraps YNAB account API endpoints
 type Service struct {
-	c api.ClientReader
+	c api.ClientReaderWriter
 }
 
 // GetAccounts fetches the list of accounts from a budget
@@ -60,3 +61,34 @@ func (s *Service) GetAccount(ctx context.Context, budgetID string, accountID str
 	}
 	return resModel.Data.Account, nil
 }
+
+// CreateAccount creates a new account for a budget
+// https://api.youneedabudget.com/v1#/Accounts/createAccount
+func (s *Service) CreateAccount(ctx context.Context, budgetID string, p PayloadAccount) (*Account, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Account *PayloadAccount `json:"account"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Account *Account `json:"account"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/accounts", budgetID)
+	if err := s.c.Post(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Account, nil
+}
@@ -2,6 +2,7 @@ package account_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -14,15 +15,8 @@ import (
 	"github.com/mellis/ynab.go/api/account"
 )
 
-func TestService_GetAccounts(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-
-			res := httpmock.NewStringResponse(200, `{
+// getAccountsResponse an API response served by TestService_GetAccounts
+const getAccountsResponse = `{
   "data": {
     "accounts": [
 			{
@@ -40,8 +34,17 @@ func TestService_GetAccounts(t *testing.T) {
     ],
     "server_knowledge": 10
   }
-}
-		`)
+}`
+
+func TestService_GetAccounts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+
+			res := httpmock.NewStringResponse(200, getAccountsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -73,14 +76,8 @@ func TestService_GetAccounts(t *testing.T) {
 	assert.Equal(t, expected, snapshot)
 }
 
-func TestService_GetAccount(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts/aa248caa-eed7-4575-a990-717386438d2c"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getAccountResponse an API response served by TestService_GetAccount
+const getAccountResponse = `{
   "data": {
     "account": {
       "id": "aa248caa-eed7-4575-a990-717386438d2c",
@@ -95,8 +92,16 @@ func TestService_GetAccount(t *testing.T) {
       "deleted": false
     }
   }
-}
-		`)
+}`
+
+func TestService_GetAccount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts/aa248caa-eed7-4575-a990-717386438d2c"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getAccountResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -125,3 +130,74 @@ func TestService_GetAccount(t *testing.T) {
 	}
 	assert.Equal(t, expected, a)
 }
+
+// createAccountResponse an API response served by TestService_CreateAccount
+const createAccountResponse = `{
+  "data": {
+    "account": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c",
+      "name": "Household savings",
+      "type": "savings",
+      "on_budget": true,
+      "closed": false,
+      "note": null,
+      "balance": 250000,
+      "cleared_balance": 250000,
+      "uncleared_balance": 0,
+      "deleted": false
+    }
+  }
+}`
+
+func TestService_CreateAccount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payload := account.PayloadAccount{
+		Name:    "Household savings",
+		Type:    account.TypeSavings,
+		Balance: 250000,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/bbdccdb0-9007-42aa-a6fe-02a3e94476be/accounts"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Account *account.PayloadAccount `json:"account"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, resModel.Account)
+
+			res := httpmock.NewStringResponse(http.StatusCreated, createAccountResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	a, err := client.Account().CreateAccount(context.Background(), "bbdccdb0-9007-42aa-a6fe-02a3e94476be", payload)
+	assert.NoError(t, err)
+
+	expected := &account.Account{
+		ID:               "aa248caa-eed7-4575-a990-717386438d2c",
+		Name:             "Household savings",
+		Type:             account.TypeSavings,
+		OnBudget:         true,
+		Balance:          int64(250000),
+		ClearedBalance:   int64(250000),
+		UnclearedBalance: int64(0),
+	}
+	assert.Equal(t, expected, a)
+}
+
+func TestService_CreateAccount_invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := ynab.NewClient("")
+	_, err := client.Account().CreateAccount(context.Background(), "bbdccdb0-9007-42aa-a6fe-02a3e94476be",
+		account.PayloadAccount{Name: "Mortgage", Type: account.TypeMortgage})
+	assert.EqualError(t, err, `account: invalid account type: "mortgage" is deprecated`)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
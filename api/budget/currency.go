@@ -0,0 +1,82 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budget
+
+import (
+	"strings"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+// Format formats an amount following the currency format, such as
+// "-$1,234.56", "¥1,235" or "1.234,560 BD". Amounts are rounded half away
+// from zero to DecimalDigits, and the currency symbol is only included
+// when DisplaySymbol is set
+func (f *CurrencyFormat) Format(m api.Milliunits) string {
+	if f == nil {
+		return m.String()
+	}
+
+	digits := int(f.DecimalDigits)
+	if digits > 3 {
+		digits = 3
+	}
+
+	s := m.Abs().Decimal(digits)
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+
+	var b strings.Builder
+	if m.Round(digits) < 0 {
+		b.WriteString("-")
+	}
+	if f.DisplaySymbol && f.SymbolFirst {
+		b.WriteString(f.CurrencySymbol)
+	}
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(f.GroupSeparator)
+		}
+		b.WriteRune(r)
+	}
+	if frac != "" {
+		b.WriteString(f.DecimalSeparator)
+		b.WriteString(frac)
+	}
+	if f.DisplaySymbol && !f.SymbolFirst {
+		b.WriteString(f.CurrencySymbol)
+	}
+	return b.String()
+}
+
+// Parse parses an amount formatted following the currency format, with or
+// without the currency symbol, such as "-1.234,56 €" for a format using
+// "," as the decimal separator
+func (f *CurrencyFormat) Parse(s string) (api.Milliunits, error) {
+	if f == nil {
+		return api.ParseMilliunits(s)
+	}
+
+	if f.CurrencySymbol != "" {
+		s = strings.ReplaceAll(s, f.CurrencySymbol, "")
+	}
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = strings.TrimSpace(s[1:])
+	}
+	if f.GroupSeparator != "" {
+		s = strings.ReplaceAll(s, f.GroupSeparator, "")
+	}
+	if f.DecimalSeparator != "" {
+		s = strings.ReplaceAll(s, f.DecimalSeparator, ".")
+	}
+	if negative {
+		s = "-" + s
+	}
+	return api.ParseMilliunits(s)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budget_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/budget"
+)
+
+var (
+	usd = &budget.CurrencyFormat{
+		ISOCode:          "USD",
+		DecimalDigits:    2,
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		SymbolFirst:      true,
+		CurrencySymbol:   "$",
+		DisplaySymbol:    true,
+	}
+	eur = &budget.CurrencyFormat{
+		ISOCode:          "EUR",
+		DecimalDigits:    2,
+		DecimalSeparator: ",",
+		GroupSeparator:   ".",
+		CurrencySymbol:   "€",
+		DisplaySymbol:    true,
+	}
+	jpy = &budget.CurrencyFormat{
+		ISOCode:          "JPY",
+		DecimalDigits:    0,
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		SymbolFirst:      true,
+		CurrencySymbol:   "¥",
+		DisplaySymbol:    true,
+	}
+	bhd = &budget.CurrencyFormat{
+		ISOCode:          "BHD",
+		DecimalDigits:    3,
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		CurrencySymbol:   " BD",
+		DisplaySymbol:    false,
+	}
+)
+
+func TestCurrencyFormat_Format(t *testing.T) {
+	table := []struct {
+		format   *budget.CurrencyFormat
+		amount   api.Milliunits
+		expected string
+	}{
+		{usd, -1234560, "-$1,234.56"},
+		{usd, 0, "$0.00"},
+		{usd, 5, "$0.01"},
+		{usd, -4, "$0.00"},
+		{usd, 1234567891230, "$1,234,567,891.23"},
+		{eur, 1234560, "1.234,56€"},
+		{eur, -100, "-0,10€"},
+		{jpy, 1234500, "¥1,235"},
+		{jpy, -999000, "-¥999"},
+		{bhd, 1234567, "1,234.567"},
+		{nil, -1234560, "-1234.56"},
+	}
+	for _, test := range table {
+		t.Run(test.expected, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.format.Format(test.amount))
+		})
+	}
+}
+
+func TestCurrencyFormat_Parse(t *testing.T) {
+	table := []struct {
+		format   *budget.CurrencyFormat
+		input    string
+		expected api.Milliunits
+	}{
+		{usd, "-$1,234.56", -1234560},
+		{usd, "$-1,234.56", -1234560},
+		{usd, "1234.5", 1234500},
+		{eur, "1.234,56€", 1234560},
+		{eur, "-1.234,56 €", -1234560},
+		{jpy, "¥1,235", 1235000},
+		{bhd, "1,234.567 BD", 1234567},
+		{nil, "-1,234.56", -1234560},
+	}
+	for _, test := range table {
+		t.Run(test.input, func(t *testing.T) {
+			m, err := test.format.Parse(test.input)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, m)
+		})
+	}
+
+	_, err := eur.Parse("1,234,56")
+	assert.Error(t, err)
+	_, err = usd.Parse("1.0001")
+	assert.Error(t, err)
+}
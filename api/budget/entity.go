@@ -30,7 +30,7 @@ type Budget struct {
 	Transactions             []*transaction.Summary                 `json:"transactions"`
 	SubTransactions          []*transaction.SubTransaction          `json:"subtransactions"`
 	ScheduledTransactions    []*transaction.ScheduledSummary        `json:"scheduled_transactions"`
-	ScheduledSubTransactions []*transaction.ScheduledSubTransaction `json:"scheduled_sub_transactions"`
+	ScheduledSubTransactions []*transaction.ScheduledSubTransaction `json:"scheduled_subtransactions"`
 
 	// DateFormat the date format setting for the budget. In some cases
 	// the format will not be available and will be specified as null.
@@ -43,9 +43,9 @@ type Budget struct {
 	// from either a web or mobile client.
 	LastModifiedOn *time.Time `json:"last_modified_on"`
 	// FirstMonth undocumented field
-	FirstMonth *api.Date `json:"first_month"`
+	FirstMonth api.NullDate `json:"first_month"`
 	// LastMonth undocumented field
-	LastMonth *api.Date `json:"last_month"`
+	LastMonth api.NullDate `json:"last_month"`
 }
 
 // Summary represents the summary of a budget
@@ -64,9 +64,9 @@ type Summary struct {
 	// from either a web or mobile client.
 	LastModifiedOn *time.Time `json:"last_modified_on"`
 	// FirstMonth undocumented field
-	FirstMonth *api.Date `json:"first_month"`
+	FirstMonth api.NullDate `json:"first_month"`
 	// LastMonth undocumented field
-	LastMonth *api.Date `json:"last_month"`
+	LastMonth api.NullDate `json:"last_month"`
 }
 
 // Snapshot represents a versioned snapshot for a budget
@@ -91,6 +91,15 @@ type DateFormat struct {
 	Format string `json:"format"`
 }
 
+// Layout returns the Go time layout equivalent to the date format, or an
+// empty string when the format is not available
+func (f *DateFormat) Layout() string {
+	if f == nil {
+		return ""
+	}
+	return api.DateLayout(f.Format)
+}
+
 // CurrencyFormat represents a currency format for a budget settings
 type CurrencyFormat struct {
 	ISOCode          string `json:"iso_code"`
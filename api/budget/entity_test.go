@@ -0,0 +1,73 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budget_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/budget"
+	"github.com/mellis/ynab.go/internal/apitest"
+)
+
+func TestDateFormat_Layout(t *testing.T) {
+	f := &budget.DateFormat{Format: "DD.MM.YYYY"}
+	assert.Equal(t, "02.01.2006", f.Layout())
+
+	date, err := api.ParseDateFor(f, "05.03.2020")
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-03-05", api.DateFormat(date))
+	assert.Equal(t, "05.03.2020", date.FormatFor(f))
+
+	// budgets without a date format use YYYY-MM-DD
+	var missing *budget.DateFormat
+	assert.Equal(t, "", missing.Layout())
+	assert.Equal(t, "2020-03-05", date.FormatFor(missing))
+}
+
+// TestResponses_JSON asserts the entities of the API responses served to
+// the service tests are encoded back unchanged
+func TestResponses_JSON(t *testing.T) {
+	table := []struct {
+		name, response, key string
+		v                   interface{}
+	}{
+		{"GetBudgets", getBudgetsResponse, "budgets", &[]*budget.Summary{}},
+		{"GetBudgets null date_format", getBudgetsNullDateFormatResponse, "budgets", &[]*budget.Summary{}},
+		{"GetBudgets null currency_format", getBudgetsNullCurrencyFormatResponse, "budgets", &[]*budget.Summary{}},
+		{"GetBudget", getBudgetResponse, "budget", &budget.Budget{}},
+		{"GetBudget null date_format", getBudgetNullDateFormatResponse, "budget", &budget.Budget{}},
+		{"GetBudget null currency_format", getBudgetNullCurrencyFormatResponse, "budget", &budget.Budget{}},
+		{"GetBudgetSettings", getBudgetSettingsResponse, "settings", &budget.Settings{}},
+		{"GetBudgetSettings null date_format", getBudgetSettingsNullDateFormatResponse, "settings", &budget.Settings{}},
+		{"GetBudgetSettings null currency_format", getBudgetSettingsNullCurrencyFormatResponse, "settings", &budget.Settings{}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			apitest.AssertDataRoundTrip(t, test.response, test.key, test.v)
+		})
+	}
+}
+
+func TestSummary_JSON(t *testing.T) {
+	fixture := `{
+		"id": "aa248caa-eed7-4575-a990-717386438d2c",
+		"name": "My Budget",
+		"last_modified_on": null,
+		"first_month": null,
+		"last_month": null,
+		"date_format": null,
+		"currency_format": null
+	}`
+	apitest.AssertRoundTrip(t, fixture, &budget.Summary{})
+
+	s := &budget.Summary{}
+	assert.NoError(t, json.Unmarshal([]byte(fixture), s))
+	assert.False(t, s.FirstMonth.Valid)
+	assert.False(t, s.LastMonth.Valid)
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 
 	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/budget"
 
 	"github.com/mellis/ynab.go"
 )
@@ -55,3 +56,17 @@ func ExampleService_GetBudgetSettings() {
 
 	// Output: *budget.Settings
 }
+
+func ExampleCurrencyFormat_Format() {
+	f := &budget.CurrencyFormat{
+		DecimalDigits:    2,
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		SymbolFirst:      true,
+		CurrencySymbol:   "$",
+		DisplaySymbol:    true,
+	}
+	fmt.Println(f.Format(api.Milliunits(-1234560)))
+
+	// Output: -$1,234.56
+}
@@ -18,21 +18,14 @@ import (
 	"github.com/mellis/ynab.go/api/budget"
 )
 
-func TestService_GetBudgets(t *testing.T) {
-	t.Run(`success`, func(t *testing.T) {
-		httpmock.Activate()
-		defer httpmock.DeactivateAndReset()
-
-		url := "https://api.youneedabudget.com/v1/budgets"
-		httpmock.RegisterResponder(http.MethodGet, url,
-			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
+// getBudgetsResponse an API response served by TestService_GetBudgets
+const getBudgetsResponse = `{
   "data": {
     "budgets": [
       {
         "id": "aa248caa-eed7-4575-a990-717386438d2c",
         "name": "TestBudget",
-        "last_modified_on": "2018-03-05T17:05:23+00:00",
+        "last_modified_on": "2018-03-05T17:05:23Z",
         "first_month": "2018-03-01",
         "last_month": "2018-04-01",
         "date_format": {
@@ -51,8 +44,62 @@ func TestService_GetBudgets(t *testing.T) {
       }
     ]
   }
-}
-		`)
+}`
+
+// getBudgetsNullDateFormatResponse an API response served by TestService_GetBudgets
+const getBudgetsNullDateFormatResponse = `{
+  "data": {
+    "budgets": [
+      {
+        "id": "aa248caa-eed7-4575-a990-717386438d2c",
+        "name": "TestBudget",
+        "last_modified_on": "2018-03-05T17:05:23Z",
+        "first_month": "2018-03-01",
+        "last_month": "2018-04-01",
+        "date_format": null,
+        "currency_format": {
+          "iso_code": "EUR",
+          "example_format": "123,456.78",
+          "decimal_digits": 2,
+          "decimal_separator": ".",
+          "symbol_first": false,
+          "group_separator": ",",
+          "currency_symbol": "€",
+          "display_symbol": true
+        }
+      }
+    ]
+  }
+}`
+
+// getBudgetsNullCurrencyFormatResponse an API response served by TestService_GetBudgets
+const getBudgetsNullCurrencyFormatResponse = `{
+  "data": {
+    "budgets": [
+      {
+        "id": "aa248caa-eed7-4575-a990-717386438d2c",
+        "name": "TestBudget",
+        "last_modified_on": "2018-03-05T17:05:23Z",
+        "first_month": "2018-03-01",
+        "last_month": "2018-04-01",
+        "date_format": {
+          "format": "DD.MM.YYYY"
+        },
+        "currency_format": null
+      }
+    ]
+  }
+}`
+
+func TestService_GetBudgets(t *testing.T) {
+	t.Run(`success`, func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := "https://api.youneedabudget.com/v1/budgets"
+		httpmock.RegisterResponder(http.MethodGet, url,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, getBudgetsResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -68,7 +115,7 @@ func TestService_GetBudgets(t *testing.T) {
 		expectedLastDate, err := api.DateFromString("2018-04-01")
 		assert.NoError(t, err)
 
-		expectedLastModifiedOn, err := time.Parse(time.RFC3339, "2018-03-05T17:05:23+00:00")
+		expectedLastModifiedOn, err := time.Parse(time.RFC3339, "2018-03-05T17:05:23Z")
 		assert.NoError(t, err)
 
 		b := budgets[0]
@@ -76,8 +123,8 @@ func TestService_GetBudgets(t *testing.T) {
 		assert.Equal(t, "aa248caa-eed7-4575-a990-717386438d2c", b.ID)
 		assert.Equal(t, "TestBudget", b.Name)
 		assert.Equal(t, &expectedLastModifiedOn, b.LastModifiedOn)
-		assert.Equal(t, &expectedFirstMonth, b.FirstMonth)
-		assert.Equal(t, &expectedLastDate, b.LastMonth)
+		assert.Equal(t, api.NewNullDate(expectedFirstMonth), b.FirstMonth)
+		assert.Equal(t, api.NewNullDate(expectedLastDate), b.LastMonth)
 		assert.Equal(t, "DD.MM.YYYY", b.DateFormat.Format)
 		assert.Equal(t, "EUR", b.CurrencyFormat.ISOCode)
 		assert.Equal(t, "123,456.78", b.CurrencyFormat.ExampleFormat)
@@ -96,31 +143,7 @@ func TestService_GetBudgets(t *testing.T) {
 		url := "https://api.youneedabudget.com/v1/budgets"
 		httpmock.RegisterResponder(http.MethodGet, url,
 			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "budgets": [
-      {
-        "id": "aa248caa-eed7-4575-a990-717386438d2c",
-        "name": "TestBudget",
-        "last_modified_on": "2018-03-05T17:05:23+00:00",
-        "first_month": "2018-03-01",
-        "last_month": "2018-04-01",
-        "date_format": null,
-        "currency_format": {
-          "iso_code": "EUR",
-          "example_format": "123,456.78",
-          "decimal_digits": 2,
-          "decimal_separator": ".",
-          "symbol_first": false,
-          "group_separator": ",",
-          "currency_symbol": "€",
-          "display_symbol": true
-        }
-      }
-    ]
-  }
-}
-		`)
+				res := httpmock.NewStringResponse(200, getBudgetsNullDateFormatResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -143,24 +166,7 @@ func TestService_GetBudgets(t *testing.T) {
 		url := "https://api.youneedabudget.com/v1/budgets"
 		httpmock.RegisterResponder(http.MethodGet, url,
 			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "budgets": [
-      {
-        "id": "aa248caa-eed7-4575-a990-717386438d2c",
-        "name": "TestBudget",
-        "last_modified_on": "2018-03-05T17:05:23+00:00",
-        "first_month": "2018-03-01",
-        "last_month": "2018-04-01",
-        "date_format": {
-          "format": "DD.MM.YYYY"
-        },
-        "currency_format": null
-      }
-    ]
-  }
-}
-		`)
+				res := httpmock.NewStringResponse(200, getBudgetsNullCurrencyFormatResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -177,20 +183,13 @@ func TestService_GetBudgets(t *testing.T) {
 	})
 }
 
-func TestService_GetBudget(t *testing.T) {
-	t.Run(`success`, func(t *testing.T) {
-		httpmock.Activate()
-		defer httpmock.DeactivateAndReset()
-
-		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
-		httpmock.RegisterResponder(http.MethodGet, url,
-			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
+// getBudgetResponse an API response served by TestService_GetBudget
+const getBudgetResponse = `{
   "data": {
     "budget": {
       "id": "aa248caa-eed7-4575-a990-717386438d2c",
       "name": "Test Budget",
-      "last_modified_on": "2018-03-05T17:24:36+00:00",
+      "last_modified_on": "2018-03-05T17:24:36Z",
       "date_format": {
         "format": "DD/MM/YYYY"
       },
@@ -219,16 +218,16 @@ func TestService_GetBudget(t *testing.T) {
           "uncleared_balance": 0,
           "deleted": false
         }
-			],
-			"payees": [
+      ],
+      "payees": [
         {
           "id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
           "name": "Starting Balance",
           "transfer_account_id": null,
           "deleted": false
         }
-			],
-			"payee_locations": [
+      ],
+      "payee_locations": [
         {
           "id": "47471638-da3e-4cdd-9288-e373b50fafa7",
           "payee_id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
@@ -236,16 +235,16 @@ func TestService_GetBudget(t *testing.T) {
           "longitude": "-33.9167891",
           "deleted": false
         }
-			],
-			"category_groups": [
+      ],
+      "category_groups": [
         {
           "id": "840512c5-3b1d-426f-b033-f7c64a16a076",
           "name": "Category group",
           "hidden": false,
           "deleted": false
         }
-			],
-			"categories": [
+      ],
+      "categories": [
         {
           "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
           "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
@@ -256,10 +255,22 @@ func TestService_GetBudget(t *testing.T) {
           "budgeted": 0,
           "activity": 12190,
           "balance": 18740,
-          "deleted": false
-        }
-			],
-			"months": [
+          "deleted": false,
+          "goal_type": null,
+          "goal_creation_month": null,
+          "goal_target": null,
+          "goal_target_month": null,
+          "goal_percentage_complete": null,
+          "goal_day": null,
+          "goal_cadence": null,
+          "goal_cadence_frequency": null,
+          "goal_under_funded": null,
+          "goal_overall_funded": null,
+          "goal_overall_left": null,
+          "goal_months_to_budget": null
+        }
+      ],
+      "months": [
         {
           "month": "2018-03-01",
           "note": null,
@@ -268,19 +279,36 @@ func TestService_GetBudget(t *testing.T) {
           "categories": [
             {
               "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          		"category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
+              "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
               "name": "Category",
               "hidden": true,
               "note": null,
               "budgeted": 0,
               "activity": 12190,
               "balance": 18740,
-              "deleted": false
+              "deleted": false,
+              "original_category_group_id": null,
+              "goal_type": null,
+              "goal_creation_month": null,
+              "goal_target": null,
+              "goal_target_month": null,
+              "goal_percentage_complete": null,
+              "goal_day": null,
+              "goal_cadence": null,
+              "goal_cadence_frequency": null,
+              "goal_under_funded": null,
+              "goal_overall_funded": null,
+              "goal_overall_left": null,
+              "goal_months_to_budget": null
             }
-					]
-				}
-			],
-			"transactions": [
+          ],
+          "income": null,
+          "budgeted": null,
+          "activity": null,
+          "deleted": false
+        }
+      ],
+      "transactions": [
         {
           "id": "e31928db-b236-4c88-9a99-7aa46ff7a6f7",
           "date": "2018-01-09",
@@ -296,8 +324,8 @@ func TestService_GetBudget(t *testing.T) {
           "import_id": null,
           "deleted": false
         }
-			],
-			"subtransactions": [
+      ],
+      "subtransactions": [
         {
           "id": "254049fe-cadc-4657-b36e-99baac0bd9ca",
           "transaction_id": "891a41b8-bc0f-4c0b-b3a3-97d5d6d61276",
@@ -306,9 +334,12 @@ func TestService_GetBudget(t *testing.T) {
           "payee_id": "33fc3c91-8489-4da7-aef5-57ccd19d60dd",
           "category_id": "2d9e60f6-0c7e-472f-8064-0465aa1c58d4",
           "transfer_account_id": null,
-          "deleted": false
+          "deleted": false,
+          "payee_name": null,
+          "category_name": null,
+          "transfer_transaction_id": null
         }
-			],
+      ],
       "scheduled_transactions": [
         {
           "id": "0971ec91-0961-42be-8598-c6d79c800b28",
@@ -324,36 +355,20 @@ func TestService_GetBudget(t *testing.T) {
           "transfer_account_id": null,
           "deleted": false
         }
-			],
+      ],
       "scheduled_subtransactions": []
-		},
+    },
     "server_knowledge": 473
   }
-}
-		`)
-				res.Header.Add("X-Rate-Limit", "36/200")
-				return res, nil
-			},
-		)
-
-		client := ynab.NewClient("")
-		_, err := client.Budget().GetBudget(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
-		assert.NoError(t, err)
-	})
-
-	t.Run(`success when date_format is null`, func(t *testing.T) {
-		httpmock.Activate()
-		defer httpmock.DeactivateAndReset()
+}`
 
-		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
-		httpmock.RegisterResponder(http.MethodGet, url,
-			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
+// getBudgetNullDateFormatResponse an API response served by TestService_GetBudget
+const getBudgetNullDateFormatResponse = `{
   "data": {
     "budget": {
       "id": "aa248caa-eed7-4575-a990-717386438d2c",
       "name": "Test Budget",
-      "last_modified_on": "2018-03-05T17:24:36+00:00",
+      "last_modified_on": "2018-03-05T17:24:36Z",
       "date_format": null,
       "currency_format": {
         "iso_code": "BRL",
@@ -380,16 +395,16 @@ func TestService_GetBudget(t *testing.T) {
           "uncleared_balance": 0,
           "deleted": false
         }
-			],
-			"payees": [
+      ],
+      "payees": [
         {
           "id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
           "name": "Starting Balance",
           "transfer_account_id": null,
           "deleted": false
         }
-			],
-			"payee_locations": [
+      ],
+      "payee_locations": [
         {
           "id": "47471638-da3e-4cdd-9288-e373b50fafa7",
           "payee_id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
@@ -397,16 +412,16 @@ func TestService_GetBudget(t *testing.T) {
           "longitude": "-33.9167891",
           "deleted": false
         }
-			],
-			"category_groups": [
+      ],
+      "category_groups": [
         {
           "id": "840512c5-3b1d-426f-b033-f7c64a16a076",
           "name": "Category group",
           "hidden": false,
           "deleted": false
         }
-			],
-			"categories": [
+      ],
+      "categories": [
         {
           "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
           "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
@@ -417,10 +432,22 @@ func TestService_GetBudget(t *testing.T) {
           "budgeted": 0,
           "activity": 12190,
           "balance": 18740,
-          "deleted": false
-        }
-			],
-			"months": [
+          "deleted": false,
+          "goal_type": null,
+          "goal_creation_month": null,
+          "goal_target": null,
+          "goal_target_month": null,
+          "goal_percentage_complete": null,
+          "goal_day": null,
+          "goal_cadence": null,
+          "goal_cadence_frequency": null,
+          "goal_under_funded": null,
+          "goal_overall_funded": null,
+          "goal_overall_left": null,
+          "goal_months_to_budget": null
+        }
+      ],
+      "months": [
         {
           "month": "2018-03-01",
           "note": null,
@@ -429,19 +456,36 @@ func TestService_GetBudget(t *testing.T) {
           "categories": [
             {
               "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          		"category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
+              "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
               "name": "Category",
               "hidden": true,
               "note": null,
               "budgeted": 0,
               "activity": 12190,
               "balance": 18740,
-              "deleted": false
+              "deleted": false,
+              "original_category_group_id": null,
+              "goal_type": null,
+              "goal_creation_month": null,
+              "goal_target": null,
+              "goal_target_month": null,
+              "goal_percentage_complete": null,
+              "goal_day": null,
+              "goal_cadence": null,
+              "goal_cadence_frequency": null,
+              "goal_under_funded": null,
+              "goal_overall_funded": null,
+              "goal_overall_left": null,
+              "goal_months_to_budget": null
             }
-					]
-				}
-			],
-			"transactions": [
+          ],
+          "income": null,
+          "budgeted": null,
+          "activity": null,
+          "deleted": false
+        }
+      ],
+      "transactions": [
         {
           "id": "e31928db-b236-4c88-9a99-7aa46ff7a6f7",
           "date": "2018-01-09",
@@ -457,8 +501,8 @@ func TestService_GetBudget(t *testing.T) {
           "import_id": null,
           "deleted": false
         }
-			],
-			"subtransactions": [
+      ],
+      "subtransactions": [
         {
           "id": "254049fe-cadc-4657-b36e-99baac0bd9ca",
           "transaction_id": "891a41b8-bc0f-4c0b-b3a3-97d5d6d61276",
@@ -467,9 +511,12 @@ func TestService_GetBudget(t *testing.T) {
           "payee_id": "33fc3c91-8489-4da7-aef5-57ccd19d60dd",
           "category_id": "2d9e60f6-0c7e-472f-8064-0465aa1c58d4",
           "transfer_account_id": null,
-          "deleted": false
+          "deleted": false,
+          "payee_name": null,
+          "category_name": null,
+          "transfer_transaction_id": null
         }
-			],
+      ],
       "scheduled_transactions": [
         {
           "id": "0971ec91-0961-42be-8598-c6d79c800b28",
@@ -485,36 +532,20 @@ func TestService_GetBudget(t *testing.T) {
           "transfer_account_id": null,
           "deleted": false
         }
-			],
+      ],
       "scheduled_subtransactions": []
-		},
+    },
     "server_knowledge": 473
   }
-}
-		`)
-				res.Header.Add("X-Rate-Limit", "36/200")
-				return res, nil
-			},
-		)
-
-		client := ynab.NewClient("")
-		_, err := client.Budget().GetBudget(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
-		assert.NoError(t, err)
-	})
-
-	t.Run(`success when currency_format is null`, func(t *testing.T) {
-		httpmock.Activate()
-		defer httpmock.DeactivateAndReset()
+}`
 
-		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
-		httpmock.RegisterResponder(http.MethodGet, url,
-			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
+// getBudgetNullCurrencyFormatResponse an API response served by TestService_GetBudget
+const getBudgetNullCurrencyFormatResponse = `{
   "data": {
     "budget": {
       "id": "aa248caa-eed7-4575-a990-717386438d2c",
       "name": "Test Budget",
-      "last_modified_on": "2018-03-05T17:24:36+00:00",
+      "last_modified_on": "2018-03-05T17:24:36Z",
       "date_format": {
         "format": "DD/MM/YYYY"
       },
@@ -534,16 +565,16 @@ func TestService_GetBudget(t *testing.T) {
           "uncleared_balance": 0,
           "deleted": false
         }
-			],
-			"payees": [
+      ],
+      "payees": [
         {
           "id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
           "name": "Starting Balance",
           "transfer_account_id": null,
           "deleted": false
         }
-			],
-			"payee_locations": [
+      ],
+      "payee_locations": [
         {
           "id": "47471638-da3e-4cdd-9288-e373b50fafa7",
           "payee_id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
@@ -551,16 +582,16 @@ func TestService_GetBudget(t *testing.T) {
           "longitude": "-33.9167891",
           "deleted": false
         }
-			],
-			"category_groups": [
+      ],
+      "category_groups": [
         {
           "id": "840512c5-3b1d-426f-b033-f7c64a16a076",
           "name": "Category group",
           "hidden": false,
           "deleted": false
         }
-			],
-			"categories": [
+      ],
+      "categories": [
         {
           "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
           "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
@@ -571,10 +602,22 @@ func TestService_GetBudget(t *testing.T) {
           "budgeted": 0,
           "activity": 12190,
           "balance": 18740,
-          "deleted": false
-        }
-			],
-			"months": [
+          "deleted": false,
+          "goal_type": null,
+          "goal_creation_month": null,
+          "goal_target": null,
+          "goal_target_month": null,
+          "goal_percentage_complete": null,
+          "goal_day": null,
+          "goal_cadence": null,
+          "goal_cadence_frequency": null,
+          "goal_under_funded": null,
+          "goal_overall_funded": null,
+          "goal_overall_left": null,
+          "goal_months_to_budget": null
+        }
+      ],
+      "months": [
         {
           "month": "2018-03-01",
           "note": null,
@@ -583,19 +626,36 @@ func TestService_GetBudget(t *testing.T) {
           "categories": [
             {
               "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          		"category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
+              "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
               "name": "Category",
               "hidden": true,
               "note": null,
               "budgeted": 0,
               "activity": 12190,
               "balance": 18740,
-              "deleted": false
+              "deleted": false,
+              "original_category_group_id": null,
+              "goal_type": null,
+              "goal_creation_month": null,
+              "goal_target": null,
+              "goal_target_month": null,
+              "goal_percentage_complete": null,
+              "goal_day": null,
+              "goal_cadence": null,
+              "goal_cadence_frequency": null,
+              "goal_under_funded": null,
+              "goal_overall_funded": null,
+              "goal_overall_left": null,
+              "goal_months_to_budget": null
             }
-					]
-				}
-			],
-			"transactions": [
+          ],
+          "income": null,
+          "budgeted": null,
+          "activity": null,
+          "deleted": false
+        }
+      ],
+      "transactions": [
         {
           "id": "e31928db-b236-4c88-9a99-7aa46ff7a6f7",
           "date": "2018-01-09",
@@ -611,8 +671,8 @@ func TestService_GetBudget(t *testing.T) {
           "import_id": null,
           "deleted": false
         }
-			],
-			"subtransactions": [
+      ],
+      "subtransactions": [
         {
           "id": "254049fe-cadc-4657-b36e-99baac0bd9ca",
           "transaction_id": "891a41b8-bc0f-4c0b-b3a3-97d5d6d61276",
@@ -621,9 +681,12 @@ func TestService_GetBudget(t *testing.T) {
           "payee_id": "33fc3c91-8489-4da7-aef5-57ccd19d60dd",
           "category_id": "2d9e60f6-0c7e-472f-8064-0465aa1c58d4",
           "transfer_account_id": null,
-          "deleted": false
+          "deleted": false,
+          "payee_name": null,
+          "category_name": null,
+          "transfer_transaction_id": null
         }
-			],
+      ],
       "scheduled_transactions": [
         {
           "id": "0971ec91-0961-42be-8598-c6d79c800b28",
@@ -639,13 +702,58 @@ func TestService_GetBudget(t *testing.T) {
           "transfer_account_id": null,
           "deleted": false
         }
-			],
+      ],
       "scheduled_subtransactions": []
-		},
+    },
     "server_knowledge": 473
   }
-}
-		`)
+}`
+
+func TestService_GetBudget(t *testing.T) {
+	t.Run(`success`, func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+		httpmock.RegisterResponder(http.MethodGet, url,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, getBudgetResponse)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		client := ynab.NewClient("")
+		_, err := client.Budget().GetBudget(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`success when date_format is null`, func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+		httpmock.RegisterResponder(http.MethodGet, url,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, getBudgetNullDateFormatResponse)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		client := ynab.NewClient("")
+		_, err := client.Budget().GetBudget(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run(`success when currency_format is null`, func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+		httpmock.RegisterResponder(http.MethodGet, url,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, getBudgetNullCurrencyFormatResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -664,12 +772,21 @@ func TestService_GetLastUsedBudget(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/last-used"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+			res := httpmock.NewStringResponse(200, getBudgetResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	_, err := client.Budget().GetLastUsedBudget(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+// getBudgetSettingsResponse an API response served by TestService_GetBudgetSettings
+const getBudgetSettingsResponse = `{
   "data": {
-    "budget": {
-      "id": "aa248caa-eed7-4575-a990-717386438d2c",
-      "name": "Test Budget",
-      "last_modified_on": "2018-03-05T17:24:36+00:00",
+    "settings": {
       "date_format": {
         "format": "DD/MM/YYYY"
       },
@@ -682,158 +799,16 @@ func TestService_GetLastUsedBudget(t *testing.T) {
         "group_separator": ".",
         "currency_symbol": "R$",
         "display_symbol": true
-      },
-      "first_month": "2017-12-01",
-      "last_month": "2018-02-01",
-      "accounts": [
-        {
-          "id": "312bf0ae-9d1a-42d7-84c1-8f1d5e4e7bb0",
-          "name": "Cash",
-          "type": "cash",
-          "on_budget": true,
-          "closed": false,
-          "note": null,
-          "balance": 0,
-          "cleared_balance": 0,
-          "uncleared_balance": 0,
-          "deleted": false
-        }
-			],
-			"payees": [
-        {
-          "id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
-          "name": "Starting Balance",
-          "transfer_account_id": null,
-          "deleted": false
-        }
-			],
-			"payee_locations": [
-        {
-          "id": "47471638-da3e-4cdd-9288-e373b50fafa7",
-          "payee_id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
-          "latitude": "20.8988754",
-          "longitude": "-33.9167891",
-          "deleted": false
-        }
-			],
-			"category_groups": [
-        {
-          "id": "840512c5-3b1d-426f-b033-f7c64a16a076",
-          "name": "Category group",
-          "hidden": false,
-          "deleted": false
-        }
-			],
-			"categories": [
-        {
-          "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          "category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
-          "name": "Category",
-          "hidden": false,
-          "original_category_group_id": null,
-          "note": null,
-          "budgeted": 0,
-          "activity": 12190,
-          "balance": 18740,
-          "deleted": false
-        }
-			],
-			"months": [
-        {
-          "month": "2018-03-01",
-          "note": null,
-          "to_be_budgeted": 0,
-          "age_of_money": null,
-          "categories": [
-            {
-              "id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          		"category_group_id": "840512c5-3b1d-426f-b033-f7c64a16a076",
-              "name": "Category",
-              "hidden": true,
-              "note": null,
-              "budgeted": 0,
-              "activity": 12190,
-              "balance": 18740,
-              "deleted": false
-            }
-					]
-				}
-			],
-			"transactions": [
-        {
-          "id": "e31928db-b236-4c88-9a99-7aa46ff7a6f7",
-          "date": "2018-01-09",
-          "amount": -85440,
-          "memo": null,
-          "cleared": "cleared",
-          "approved": true,
-          "flag_color": null,
-          "account_id": "312bf0ae-9d1a-42d7-84c1-8f1d5e4e7bb0",
-          "payee_id": "fa8d442e-0bfc-4386-8e5b-480c4f70733a",
-          "category_id": "0d3552a4-49da-4191-bac6-e22f80eb2056",
-          "transfer_account_id": null,
-          "import_id": null,
-          "deleted": false
-        }
-			],
-			"subtransactions": [
-        {
-          "id": "254049fe-cadc-4657-b36e-99baac0bd9ca",
-          "transaction_id": "891a41b8-bc0f-4c0b-b3a3-97d5d6d61276",
-          "amount": 0,
-          "memo": null,
-          "payee_id": "33fc3c91-8489-4da7-aef5-57ccd19d60dd",
-          "category_id": "2d9e60f6-0c7e-472f-8064-0465aa1c58d4",
-          "transfer_account_id": null,
-          "deleted": false
-        }
-			],
-      "scheduled_transactions": [
-        {
-          "id": "0971ec91-0961-42be-8598-c6d79c800b28",
-          "date_first": "2018-11-20",
-          "date_next": "2018-11-20",
-          "frequency": "never",
-          "amount": -17000,
-          "memo": "Domain bmvs.me",
-          "flag_color": "yellow",
-          "account_id": "09eaca5e-2a34-4baa-89c4-828fb90638f2",
-          "payee_id": "793846ad-f8f5-454e-9ae4-8d938d0d89ca",
-          "category_id": "138c8bcd-6ca3-4c09-82ca-1cde7aa1d6f8",
-          "transfer_account_id": null,
-          "deleted": false
-        }
-			],
-      "scheduled_subtransactions": []
-		},
-    "server_knowledge": 473
+      }
+    }
   }
-}
-		`)
-			res.Header.Add("X-Rate-Limit", "36/200")
-			return res, nil
-		},
-	)
-
-	client := ynab.NewClient("")
-	_, err := client.Budget().GetLastUsedBudget(context.Background(), nil)
-	assert.NoError(t, err)
-}
+}`
 
-func TestService_GetBudgetSettings(t *testing.T) {
-	t.Run(`success`, func(t *testing.T) {
-		httpmock.Activate()
-		defer httpmock.DeactivateAndReset()
-
-		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/settings"
-		httpmock.RegisterResponder(http.MethodGet, url,
-			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
+// getBudgetSettingsNullDateFormatResponse an API response served by TestService_GetBudgetSettings
+const getBudgetSettingsNullDateFormatResponse = `{
   "data": {
     "settings": {
-      "date_format": {
-        "format": "DD/MM/YYYY"
-      },
+      "date_format": null,
       "currency_format": {
         "iso_code": "BRL",
         "example_format": "123.456,78",
@@ -846,7 +821,29 @@ func TestService_GetBudgetSettings(t *testing.T) {
       }
     }
   }
-}`)
+}`
+
+// getBudgetSettingsNullCurrencyFormatResponse an API response served by TestService_GetBudgetSettings
+const getBudgetSettingsNullCurrencyFormatResponse = `{
+  "data": {
+    "settings": {
+      "date_format": {
+        "format": "DD/MM/YYYY"
+      },
+      "currency_format": null
+    }
+  }
+}`
+
+func TestService_GetBudgetSettings(t *testing.T) {
+	t.Run(`success`, func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/settings"
+		httpmock.RegisterResponder(http.MethodGet, url,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, getBudgetSettingsResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -882,23 +879,7 @@ func TestService_GetBudgetSettings(t *testing.T) {
 		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/settings"
 		httpmock.RegisterResponder(http.MethodGet, url,
 			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "settings": {
-      "date_format": null,
-      "currency_format": {
-        "iso_code": "BRL",
-        "example_format": "123.456,78",
-        "decimal_digits": 2,
-        "decimal_separator": ",",
-        "symbol_first": true,
-        "group_separator": ".",
-        "currency_symbol": "R$",
-        "display_symbol": true
-      }
-    }
-  }
-}`)
+				res := httpmock.NewStringResponse(200, getBudgetSettingsNullDateFormatResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -931,16 +912,7 @@ func TestService_GetBudgetSettings(t *testing.T) {
 		url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/settings"
 		httpmock.RegisterResponder(http.MethodGet, url,
 			func(req *http.Request) (*http.Response, error) {
-				res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "settings": {
-      "date_format": {
-        "format": "DD/MM/YYYY"
-      },
-      "currency_format": null
-    }
-  }
-}`)
+				res := httpmock.NewStringResponse(200, getBudgetSettingsNullCurrencyFormatResponse)
 				res.Header.Add("X-Rate-Limit", "36/200")
 				return res, nil
 			},
@@ -29,14 +29,38 @@ type Category struct {
 
 	GoalType *Goal `json:"goal_type"`
 	// GoalCreationMonth the month a goal was created
-	GoalCreationMonth *api.Date `json:"goal_creation_month"`
+	GoalCreationMonth api.NullDate `json:"goal_creation_month"`
 	// GoalTarget the goal target amount in milliunits
 	GoalTarget *int64 `json:"goal_target"`
 	// GoalTargetMonth if the goal type is GoalTargetCategoryBalanceByDate,
 	// this is the target month for the goal to be completed
-	GoalTargetMonth *api.Date `json:"goal_target_month"`
+	GoalTargetMonth api.NullDate `json:"goal_target_month"`
 	// GoalPercentageComplete the percentage completion of the goal
 	GoalPercentageComplete *uint16 `json:"goal_percentage_complete"`
+	// GoalDay the day the goal is due within its cadence period. For a
+	// weekly cadence it is the day of the week, 0 being Sunday, otherwise
+	// the day of the month, nil being the last day of the month
+	GoalDay *int `json:"goal_day"`
+	// GoalCadence the period the goal repeats over
+	GoalCadence *GoalCadence `json:"goal_cadence"`
+	// GoalCadenceFrequency the number of cadence periods between two
+	// repetitions. It only applies to the none, monthly, weekly and
+	// yearly cadences (0, 1, 2 and 13), the other cadences having a
+	// fixed period
+	GoalCadenceFrequency *int `json:"goal_cadence_frequency"`
+	// GoalUnderFunded the amount still needed in the current month to stay
+	// on track towards the goal within the current goal period, in
+	// milliunits
+	GoalUnderFunded *int64 `json:"goal_under_funded"`
+	// GoalOverallFunded the amount funded towards the goal within the
+	// current goal period, in milliunits
+	GoalOverallFunded *int64 `json:"goal_overall_funded"`
+	// GoalOverallLeft the amount still needed to complete the goal within
+	// the current goal period, in milliunits
+	GoalOverallLeft *int64 `json:"goal_overall_left"`
+	// GoalMonthsToBudget the number of months, the current month included,
+	// left in the current goal period
+	GoalMonthsToBudget *int `json:"goal_months_to_budget"`
 }
 
 // Group represents a resumed category group for a budget
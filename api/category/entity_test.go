@@ -0,0 +1,141 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/internal/apitest"
+)
+
+// TestResponses_JSON asserts the entities of the API responses served to
+// the service tests are encoded back unchanged
+func TestResponses_JSON(t *testing.T) {
+	table := []struct {
+		name, response, key string
+		v                   interface{}
+	}{
+		{"GetCategories", getCategoriesResponse, "category_groups", &[]*category.GroupWithCategories{}},
+		{"GetCategory", getCategoryResponse, "category", &category.Category{}},
+		{"GetCategoryAt", getCategoryAtResponse, "category", &category.Category{}},
+		{"UpdateCategoryForMonth", updateCategoryForMonthResponse, "category", &category.Category{}},
+		{"UpdateCategory", updateCategoryResponse, "category", &category.Category{}},
+		{"CreateCategory", createCategoryResponse, "category", &category.Category{}},
+		{"CreateCategoryGroup", createCategoryGroupResponse, "category_group", &category.Group{}},
+		{"UpdateCategoryGroup", updateCategoryGroupResponse, "category_group", &category.Group{}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			apitest.AssertDataRoundTrip(t, test.response, test.key, test.v)
+		})
+	}
+}
+
+// categoryFixtures cover categories with and without goal months
+var categoryFixtures = map[string]string{
+	"goal": `{
+		"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		"name": "MasterCard",
+		"hidden": false,
+		"budgeted": 1000,
+		"activity": 12190,
+		"balance": 18740,
+		"deleted": false,
+		"note": "card",
+		"original_category_group_id": null,
+		"goal_type": "TBD",
+		"goal_creation_month": "2018-04-01",
+		"goal_target": 18740,
+		"goal_target_month": "2018-05-01",
+		"goal_percentage_complete": 20,
+		"goal_day": null,
+		"goal_cadence": 0,
+		"goal_cadence_frequency": null,
+		"goal_under_funded": 2000,
+		"goal_overall_funded": 10000,
+		"goal_overall_left": 8740,
+		"goal_months_to_budget": 2
+	}`,
+	"no goal": `{
+		"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		"name": "Hidden",
+		"hidden": true,
+		"budgeted": 0,
+		"activity": 0,
+		"balance": 0,
+		"deleted": true,
+		"note": null,
+		"original_category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		"goal_type": null,
+		"goal_creation_month": null,
+		"goal_target": null,
+		"goal_target_month": null,
+		"goal_percentage_complete": null,
+		"goal_day": null,
+		"goal_cadence": null,
+		"goal_cadence_frequency": null,
+		"goal_under_funded": null,
+		"goal_overall_funded": null,
+		"goal_overall_left": null,
+		"goal_months_to_budget": null
+	}`,
+}
+
+func TestCategory_JSON(t *testing.T) {
+	for name, fixture := range categoryFixtures {
+		t.Run(name, func(t *testing.T) {
+			apitest.AssertRoundTrip(t, fixture, &category.Category{})
+		})
+	}
+
+	c := &category.Category{}
+	assert.NoError(t, json.Unmarshal([]byte(categoryFixtures["no goal"]), c))
+	assert.False(t, c.GoalCreationMonth.Valid)
+	assert.False(t, c.GoalTargetMonth.Valid)
+
+	assert.NoError(t, json.Unmarshal([]byte(categoryFixtures["goal"]), c))
+	assert.True(t, c.GoalTargetMonth.Valid)
+	assert.Equal(t, "2018-05-01 00:00:00 +0000 UTC", c.GoalTargetMonth.String())
+}
+
+func TestCategory_JSON_need(t *testing.T) {
+	fixture := `{
+		"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+		"name": "Groceries",
+		"hidden": false,
+		"budgeted": 100000,
+		"activity": -20000,
+		"balance": 80000,
+		"deleted": false,
+		"note": null,
+		"original_category_group_id": null,
+		"goal_type": "NEED",
+		"goal_creation_month": "2018-04-01",
+		"goal_target": 50000,
+		"goal_target_month": null,
+		"goal_percentage_complete": 100,
+		"goal_day": 6,
+		"goal_cadence": 2,
+		"goal_cadence_frequency": 1,
+		"goal_under_funded": 0,
+		"goal_overall_funded": 100000,
+		"goal_overall_left": 0,
+		"goal_months_to_budget": 1
+	}`
+	apitest.AssertRoundTrip(t, fixture, &category.Category{})
+
+	c := &category.Category{}
+	assert.NoError(t, json.Unmarshal([]byte(fixture), c))
+	assert.Equal(t, category.GoalNeed, *c.GoalType)
+	assert.Equal(t, category.GoalCadenceWeekly, *c.GoalCadence)
+	assert.Equal(t, 6, *c.GoalDay)
+}
@@ -19,4 +19,50 @@ const (
 	GoalTargetCategoryBalanceByDate Goal = "TBD"
 	// GoalMonthlyFunding Goal by monthly funding
 	GoalMonthlyFunding Goal = "MF"
+	// GoalNeed Goal needed for spending, a plan to spend the target amount
+	// every cadence period
+	GoalNeed Goal = "NEED"
+	// GoalDebt Goal paying down a debt account by a monthly amount
+	GoalDebt Goal = "DEBT"
 )
+
+// GoalCadence represents the period a goal repeats over
+type GoalCadence int
+
+const (
+	// GoalCadenceNone the goal does not repeat
+	GoalCadenceNone GoalCadence = 0
+	// GoalCadenceMonthly the goal repeats every GoalCadenceFrequency months
+	GoalCadenceMonthly GoalCadence = 1
+	// GoalCadenceWeekly the goal repeats every GoalCadenceFrequency weeks
+	GoalCadenceWeekly GoalCadence = 2
+	// GoalCadenceEvery2Months the goal repeats every 2 months
+	GoalCadenceEvery2Months GoalCadence = 3
+	// GoalCadenceEvery3Months the goal repeats every 3 months
+	GoalCadenceEvery3Months GoalCadence = 4
+	// GoalCadenceEvery4Months the goal repeats every 4 months
+	GoalCadenceEvery4Months GoalCadence = 5
+	// GoalCadenceEvery5Months the goal repeats every 5 months
+	GoalCadenceEvery5Months GoalCadence = 6
+	// GoalCadenceEvery6Months the goal repeats every 6 months
+	GoalCadenceEvery6Months GoalCadence = 7
+	// GoalCadenceEvery7Months the goal repeats every 7 months
+	GoalCadenceEvery7Months GoalCadence = 8
+	// GoalCadenceEvery8Months the goal repeats every 8 months
+	GoalCadenceEvery8Months GoalCadence = 9
+	// GoalCadenceEvery9Months the goal repeats every 9 months
+	GoalCadenceEvery9Months GoalCadence = 10
+	// GoalCadenceEvery10Months the goal repeats every 10 months
+	GoalCadenceEvery10Months GoalCadence = 11
+	// GoalCadenceEvery11Months the goal repeats every 11 months
+	GoalCadenceEvery11Months GoalCadence = 12
+	// GoalCadenceYearly the goal repeats every GoalCadenceFrequency years
+	GoalCadenceYearly GoalCadence = 13
+	// GoalCadenceEvery2Years the goal repeats every 2 years
+	GoalCadenceEvery2Years GoalCadence = 14
+)
+
+// Valid reports whether the cadence is one of the known cadences
+func (c GoalCadence) Valid() bool {
+	return c >= GoalCadenceNone && c <= GoalCadenceEvery2Years
+}
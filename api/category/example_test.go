@@ -73,3 +73,48 @@ func ExampleService_UpdateCategoryForCurrentMonth() {
 
 	// Output: *category.Category
 }
+
+func ExampleService_GetCategoryAt() {
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	c, _ := client.Category().GetCategoryAt(context.Background(), "<valid_budget_id>",
+		"<valid_category_id>", api.CurrentMonth(nil).Prev())
+	fmt.Println(reflect.TypeOf(c))
+
+	// Output: *category.Category
+}
+
+func ExampleService_UpdateCategory() {
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	name := "Groceries"
+	p := category.PayloadCategory{Name: &name}
+	c, _ := client.Category().UpdateCategory(context.Background(), "<valid_budget_id>",
+		"<valid_category_id>", p)
+	fmt.Println(reflect.TypeOf(c))
+
+	// Output: *category.Category
+}
+
+func ExampleService_ApplyTemplate() {
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	t := category.Template{
+		Groups: []category.TemplateGroup{
+			{
+				Name:       "Bills",
+				Categories: []category.TemplateCategory{{Name: "Rent"}, {Name: "Electricity"}},
+			},
+		},
+	}
+	r, _ := client.Category().ApplyTemplate(context.Background(), "<valid_budget_id>", t)
+	fmt.Println(reflect.TypeOf(r))
+
+	// Output: *category.TemplateResult
+}
+
+func ExampleService_MoveMoney() {
+	client := ynab.NewClient("<valid_ynab_access_token>")
+	from, _, _ := client.Category().MoveMoney(context.Background(), "<valid_budget_id>",
+		api.CurrentMonth(nil), "<valid_category_id>", "<other_valid_category_id>", 10000)
+	fmt.Println(reflect.TypeOf(from))
+
+	// Output: *category.Category
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category
+
+import "github.com/mellis/ynab.go/api"
+
+// NeededThisMonth returns the amount, in milliunits, still to be assigned
+// to the category in a month to stay on track towards its goal, month
+// being the month the category was fetched for. GoalUnderFunded is used
+// when the API provides it, otherwise the amount is derived from the goal
+// type and the category amounts:
+//
+//   - MF and DEBT goals need the target assigned every month
+//   - TB goals need the balance to reach the target
+//   - TBD and NEED goals spread what is left of the target evenly over
+//     the months left in the goal period
+//
+// Categories without a goal, and goals already on track, need nothing
+func (c *Category) NeededThisMonth(month api.Month) int64 {
+	if c.GoalUnderFunded != nil {
+		return nonNegative(*c.GoalUnderFunded)
+	}
+	if c.GoalType == nil || c.GoalTarget == nil {
+		return 0
+	}
+
+	target := *c.GoalTarget
+	switch *c.GoalType {
+	case GoalMonthlyFunding, GoalDebt:
+		return nonNegative(target - c.Budgeted)
+
+	case GoalTargetCategoryBalance:
+		return nonNegative(target - c.Balance)
+
+	case GoalTargetCategoryBalanceByDate, GoalNeed:
+		// funded before this month's assignment
+		funded := c.Balance - c.Budgeted
+		if c.GoalOverallFunded != nil {
+			funded = *c.GoalOverallFunded - c.Budgeted
+		}
+
+		left := target - funded
+		if left <= 0 {
+			return 0
+		}
+		months := int64(c.monthsToBudget(month))
+		perMonth := (left + months - 1) / months
+		return nonNegative(perMonth - c.Budgeted)
+	}
+	return 0
+}
+
+// monthsToBudget returns the number of months, month included, left to
+// fund the goal
+func (c *Category) monthsToBudget(month api.Month) int {
+	if c.GoalMonthsToBudget != nil && *c.GoalMonthsToBudget > 0 {
+		return *c.GoalMonthsToBudget
+	}
+	if c.GoalTargetMonth.Valid {
+		if months := len(api.MonthRange(month, api.MonthOf(c.GoalTargetMonth.Time))); months > 0 {
+			return months
+		}
+	}
+	return 1
+}
+
+func nonNegative(amount int64) int64 {
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+)
+
+func TestCategory_NeededThisMonth(t *testing.T) {
+	amount := func(v int64) *int64 { return &v }
+	months := func(v int) *int { return &v }
+	targetMonth := func(s string) api.NullDate {
+		d, err := api.DateFromString(s)
+		assert.NoError(t, err)
+		return api.NewNullDate(d)
+	}
+	may := api.NewMonth(2018, time.May)
+
+	table := []struct {
+		Name     string
+		Category category.Category
+		Expected int64
+	}{
+		{
+			Name:     "no goal",
+			Category: category.Category{Budgeted: 1000, Balance: 1000},
+		},
+		{
+			Name: "under funded from the API",
+			Category: category.Category{
+				GoalType:        category.GoalTargetCategoryBalance.Pointer(),
+				GoalTarget:      amount(100000),
+				GoalUnderFunded: amount(2500),
+			},
+			Expected: 2500,
+		},
+		{
+			Name: "monthly funding",
+			Category: category.Category{
+				GoalType:   category.GoalMonthlyFunding.Pointer(),
+				GoalTarget: amount(50000),
+				Budgeted:   20000,
+				Balance:    90000,
+			},
+			Expected: 30000,
+		},
+		{
+			Name: "monthly funding fully assigned",
+			Category: category.Category{
+				GoalType:   category.GoalMonthlyFunding.Pointer(),
+				GoalTarget: amount(50000),
+				Budgeted:   60000,
+			},
+		},
+		{
+			Name: "debt",
+			Category: category.Category{
+				GoalType:   category.GoalDebt.Pointer(),
+				GoalTarget: amount(150000),
+				Budgeted:   100000,
+			},
+			Expected: 50000,
+		},
+		{
+			Name: "target balance",
+			Category: category.Category{
+				GoalType:   category.GoalTargetCategoryBalance.Pointer(),
+				GoalTarget: amount(100000),
+				Budgeted:   10000,
+				Balance:    70000,
+			},
+			Expected: 30000,
+		},
+		{
+			Name: "target balance by date",
+			Category: category.Category{
+				GoalType:        category.GoalTargetCategoryBalanceByDate.Pointer(),
+				GoalTarget:      amount(100000),
+				GoalTargetMonth: targetMonth("2018-08-01"),
+				Budgeted:        5000,
+				Balance:         45000,
+			},
+			// 60000 left over May to August
+			Expected: 10000,
+		},
+		{
+			Name: "target balance by date rounds up",
+			Category: category.Category{
+				GoalType:        category.GoalTargetCategoryBalanceByDate.Pointer(),
+				GoalTarget:      amount(100000),
+				GoalTargetMonth: targetMonth("2018-07-01"),
+			},
+			Expected: 33334,
+		},
+		{
+			Name: "target balance by date overdue",
+			Category: category.Category{
+				GoalType:        category.GoalTargetCategoryBalanceByDate.Pointer(),
+				GoalTarget:      amount(100000),
+				GoalTargetMonth: targetMonth("2018-01-01"),
+				Balance:         40000,
+			},
+			Expected: 60000,
+		},
+		{
+			Name: "need with months to budget",
+			Category: category.Category{
+				GoalType:           category.GoalNeed.Pointer(),
+				GoalTarget:         amount(120000),
+				GoalMonthsToBudget: months(3),
+				GoalOverallFunded:  amount(30000),
+				Budgeted:           10000,
+				Balance:            5000,
+			},
+			// 100000 left over 3 months
+			Expected: 23334,
+		},
+		{
+			Name: "need on track",
+			Category: category.Category{
+				GoalType:   category.GoalNeed.Pointer(),
+				GoalTarget: amount(50000),
+				Budgeted:   50000,
+				Balance:    50000,
+			},
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, test.Category.NeededThisMonth(may))
+		})
+	}
+}
+
+func TestGoalCadence_Valid(t *testing.T) {
+	assert.True(t, category.GoalCadenceNone.Valid())
+	assert.True(t, category.GoalCadenceEvery2Years.Valid())
+	assert.False(t, category.GoalCadence(15).Valid())
+	assert.False(t, category.GoalCadence(-1).Valid())
+}
+
+func TestGoalCadence_UnmarshalJSON(t *testing.T) {
+	table := []struct {
+		json    string
+		cadence category.GoalCadence
+	}{
+		{json: `{"goal_cadence": 0}`, cadence: category.GoalCadenceNone},
+		{json: `{"goal_cadence": 1}`, cadence: category.GoalCadenceMonthly},
+		{json: `{"goal_cadence": 2}`, cadence: category.GoalCadenceWeekly},
+		{json: `{"goal_cadence": 3}`, cadence: category.GoalCadenceEvery2Months},
+		{json: `{"goal_cadence": 12}`, cadence: category.GoalCadenceEvery11Months},
+		{json: `{"goal_cadence": 13}`, cadence: category.GoalCadenceYearly},
+		{json: `{"goal_cadence": 14}`, cadence: category.GoalCadenceEvery2Years},
+	}
+
+	for _, test := range table {
+		t.Run(test.json, func(t *testing.T) {
+			c := &category.Category{}
+			assert.NoError(t, json.Unmarshal([]byte(test.json), c))
+			assert.Equal(t, test.cadence, *c.GoalCadence)
+		})
+	}
+}
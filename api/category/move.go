@@ -0,0 +1,103 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+// ErrConcurrentModification is returned when a category budgeted amount
+// changed while money was being moved, such as by an edit from a YNAB app
+var ErrConcurrentModification = errors.New("category: concurrent modification")
+
+var errInvalidMove = errors.New("category: invalid money movement")
+
+// restoreTimeout bounds the restore of the source category of a failed
+// money movement
+const restoreTimeout = 30 * time.Second
+
+// MoveMoney moves an amount, in milliunits, from the budgeted amount of a
+// category to another for a month, returning both updated categories.
+//
+// The source category is written first. Should the destination category
+// change before it is written, or its write fail, the amount is given back
+// to the source category, also once ctx is done. Both categories are read
+// again once written, and ErrConcurrentModification is returned along
+// with them when either was modified meanwhile, in which case the money
+// was moved but other edits were made on top of it
+func (s *Service) MoveMoney(ctx context.Context, budgetID string, month api.Month, fromID, toID string,
+	amount int64) (*Category, *Category, error) {
+
+	if fromID == toID {
+		return nil, nil, fmt.Errorf("%w: source and destination are the same category", errInvalidMove)
+	}
+	if amount <= 0 {
+		return nil, nil, fmt.Errorf("%w: amount must be positive", errInvalidMove)
+	}
+
+	from, err := s.GetCategoryAt(ctx, budgetID, fromID, month)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := s.GetCategoryAt(ctx, budgetID, toID, month)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromBudgeted, toBudgeted := from.Budgeted-amount, to.Budgeted+amount
+	if _, err := s.UpdateCategoryAt(ctx, budgetID, fromID, month,
+		PayloadMonthCategory{Budgeted: fromBudgeted}); err != nil {
+		return nil, nil, err
+	}
+
+	current, err := s.GetCategoryAt(ctx, budgetID, toID, month)
+	if err == nil && current.Budgeted != to.Budgeted {
+		err = fmt.Errorf("%w: %s budgeted changed from %d to %d", ErrConcurrentModification,
+			toID, to.Budgeted, current.Budgeted)
+	}
+	if err == nil {
+		_, err = s.UpdateCategoryAt(ctx, budgetID, toID, month, PayloadMonthCategory{Budgeted: toBudgeted})
+	}
+	if err != nil {
+		if rerr := s.restore(ctx, budgetID, fromID, month, amount); rerr != nil {
+			return nil, nil, fmt.Errorf("category: moving money: %w, restoring %s failed: %v", err, fromID, rerr)
+		}
+		return nil, nil, err
+	}
+
+	if from, err = s.GetCategoryAt(ctx, budgetID, fromID, month); err != nil {
+		return nil, nil, err
+	}
+	if to, err = s.GetCategoryAt(ctx, budgetID, toID, month); err != nil {
+		return nil, nil, err
+	}
+	if from.Budgeted != fromBudgeted || to.Budgeted != toBudgeted {
+		return from, to, fmt.Errorf("%w: budgeted amounts changed after the money was moved",
+			ErrConcurrentModification)
+	}
+	return from, to, nil
+}
+
+// restore gives the amount of a failed money movement back to the source
+// category. The category is read again first, so edits made to it since
+// the movement started are kept. It runs on its own deadline, as the
+// movement may have failed because ctx was cancelled
+func (s *Service) restore(ctx context.Context, budgetID, fromID string, month api.Month, amount int64) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreTimeout)
+	defer cancel()
+
+	from, err := s.GetCategoryAt(ctx, budgetID, fromID, month)
+	if err != nil {
+		return err
+	}
+	_, err = s.UpdateCategoryAt(ctx, budgetID, fromID, month,
+		PayloadMonthCategory{Budgeted: from.Budgeted + amount})
+	return err
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+)
+
+// monthCategories fakes the month categories endpoints of May 2018 over
+// the budgeted amounts of two categories, from and to
+type monthCategories struct {
+	budgeted map[string]int64
+	// puts the number of updates received
+	puts int
+	// onPut is called after each update, before responding
+	onPut func(puts int)
+	// failing the category whose updates fail
+	failing string
+	// cancel is called on the first update of cancelOn, which then fails
+	// as if ctx was cancelled while the request was in flight
+	cancel   func()
+	cancelOn string
+}
+
+func (m *monthCategories) register(t *testing.T) {
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-05-01/categories/"
+	for id := range m.budgeted {
+		id := id
+		respond := func() (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(
+				`{"data":{"category":{"id":%q,"budgeted":%d}}}`, id, m.budgeted[id]))
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		}
+
+		httpmock.RegisterResponder(http.MethodGet, url+id,
+			func(req *http.Request) (*http.Response, error) {
+				return respond()
+			},
+		)
+		httpmock.RegisterResponder(http.MethodPut, url+id,
+			func(req *http.Request) (*http.Response, error) {
+				if err := req.Context().Err(); err != nil {
+					return nil, err
+				}
+				if id == m.cancelOn && m.cancel != nil {
+					m.cancel()
+					m.cancel = nil
+					return nil, context.Canceled
+				}
+				if id == m.failing {
+					return httpmock.NewStringResponse(http.StatusInternalServerError,
+						`{"error":{"id":"500","name":"internal_server_error","detail":"Something went wrong"}}`), nil
+				}
+
+				payload := struct {
+					MonthCategory category.PayloadMonthCategory `json:"month_category"`
+				}{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				m.budgeted[id] = payload.MonthCategory.Budgeted
+				m.puts++
+				if m.onPut != nil {
+					m.onPut(m.puts)
+				}
+				return respond()
+			},
+		)
+	}
+}
+
+func TestService_MoveMoney(t *testing.T) {
+	may := api.NewMonth(2018, time.May)
+
+	t.Run("success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		m := &monthCategories{budgeted: map[string]int64{"from": 50000, "to": 10000}}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		from, to, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(30000), from.Budgeted)
+		assert.Equal(t, int64(30000), to.Budgeted)
+		assert.Equal(t, map[string]int64{"from": 30000, "to": 30000}, m.budgeted)
+	})
+
+	t.Run("destination modified before its write", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		m := &monthCategories{budgeted: map[string]int64{"from": 50000, "to": 10000}}
+		m.onPut = func(puts int) {
+			if puts == 1 {
+				m.budgeted["to"] = 15000
+			}
+		}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		_, _, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.True(t, errors.Is(err, category.ErrConcurrentModification))
+		assert.Equal(t, map[string]int64{"from": 50000, "to": 15000}, m.budgeted)
+	})
+
+	t.Run("destination write fails", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		m := &monthCategories{budgeted: map[string]int64{"from": 50000, "to": 10000}, failing: "to"}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		_, _, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.True(t, errors.Is(err, api.ErrInternal))
+		assert.Equal(t, map[string]int64{"from": 50000, "to": 10000}, m.budgeted)
+		assert.Equal(t, 2, m.puts)
+	})
+
+	t.Run("source modified before the restore", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		m := &monthCategories{budgeted: map[string]int64{"from": 50000, "to": 10000}, failing: "to"}
+		m.onPut = func(puts int) {
+			if puts == 1 {
+				m.budgeted["from"] = 35000
+			}
+		}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		_, _, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.True(t, errors.Is(err, api.ErrInternal))
+		// the edit of the source is kept, only the amount is given back
+		assert.Equal(t, map[string]int64{"from": 55000, "to": 10000}, m.budgeted)
+	})
+
+	t.Run("destination write fails as ctx is cancelled", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		m := &monthCategories{
+			budgeted: map[string]int64{"from": 50000, "to": 10000},
+			cancel:   cancel,
+			cancelOn: "to",
+		}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		_, _, err := client.Category().MoveMoney(ctx,
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Error(t, ctx.Err())
+		assert.Equal(t, map[string]int64{"from": 50000, "to": 10000}, m.budgeted)
+		assert.Equal(t, 2, m.puts)
+	})
+
+	t.Run("modified after the move", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		m := &monthCategories{budgeted: map[string]int64{"from": 50000, "to": 10000}}
+		m.onPut = func(puts int) {
+			if puts == 2 {
+				m.budgeted["from"] = 0
+			}
+		}
+		m.register(t)
+
+		client := ynab.NewClient("")
+		from, to, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 20000)
+		assert.True(t, errors.Is(err, category.ErrConcurrentModification))
+		assert.Equal(t, int64(0), from.Budgeted)
+		assert.Equal(t, int64(30000), to.Budgeted)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		client := ynab.NewClient("")
+		_, _, err := client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "from", 20000)
+		assert.EqualError(t, err, "category: invalid money movement: source and destination are the same category")
+		_, _, err = client.Category().MoveMoney(context.Background(),
+			"aa248caa-eed7-4575-a990-717386438d2c", may, "from", "to", 0)
+		assert.EqualError(t, err, "category: invalid money movement: amount must be positive")
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+}
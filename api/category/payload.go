@@ -4,7 +4,83 @@
 
 package category
 
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	errEmptyPayload      = errors.New("category: payload has no field to update")
+	errInvalidName       = errors.New("category: invalid category name")
+	errInvalidGoalTarget = errors.New("category: goal target must not be negative")
+	errInvalidGroupName  = errors.New("category: invalid category group name")
+	errMissingGroup      = errors.New("category: category group is required")
+)
+
 // PayloadMonthCategory is the payload contract for updating a category for a month
 type PayloadMonthCategory struct {
-	Budgeted int64
+	// Budgeted The budgeted amount in milliunits format
+	Budgeted int64 `json:"budgeted"`
+	// GoalTarget The goal target amount in milliunits format, left
+	// unchanged when nil
+	GoalTarget *int64 `json:"goal_target,omitempty"`
+}
+
+// Validate checks the goal target is not negative
+func (p PayloadMonthCategory) Validate() error {
+	if p.GoalTarget != nil && *p.GoalTarget < 0 {
+		return errInvalidGoalTarget
+	}
+	return nil
+}
+
+// PayloadCategory is the payload contract for creating or updating a
+// category. On updates nil fields are left unchanged
+type PayloadCategory struct {
+	Name *string `json:"name,omitempty"`
+	Note *string `json:"note,omitempty"`
+	// CategoryGroupID The category group to move the category to
+	CategoryGroupID *string `json:"category_group_id,omitempty"`
+	// GoalTarget The goal target amount in milliunits format
+	GoalTarget *int64 `json:"goal_target,omitempty"`
+}
+
+// Validate checks the payload updates at least one field, with a non
+// empty name and a goal target which is not negative
+func (p PayloadCategory) Validate() error {
+	if p.Name == nil && p.Note == nil && p.CategoryGroupID == nil && p.GoalTarget == nil {
+		return errEmptyPayload
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return errInvalidName
+	}
+	if p.GoalTarget != nil && *p.GoalTarget < 0 {
+		return errInvalidGoalTarget
+	}
+	return nil
+}
+
+// validateCreate checks a new category has a name and a category group
+func (p PayloadCategory) validateCreate() error {
+	if p.Name == nil {
+		return errInvalidName
+	}
+	if p.CategoryGroupID == nil || *p.CategoryGroupID == "" {
+		return errMissingGroup
+	}
+	return p.Validate()
+}
+
+// PayloadCategoryGroup is the payload contract for creating or updating a
+// category group
+type PayloadCategoryGroup struct {
+	Name string `json:"name"`
+}
+
+// Validate checks the category group has a name
+func (p PayloadCategoryGroup) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errInvalidGroupName
+	}
+	return nil
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api/category"
+)
+
+func TestPayloadMonthCategory_JSON(t *testing.T) {
+	buf, err := json.Marshal(category.PayloadMonthCategory{Budgeted: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"budgeted":1000}`, string(buf))
+
+	target := int64(50000)
+	buf, err = json.Marshal(category.PayloadMonthCategory{Budgeted: 1000, GoalTarget: &target})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"budgeted":1000,"goal_target":50000}`, string(buf))
+}
+
+func TestPayloadCategory_Validate(t *testing.T) {
+	var (
+		name           = "Groceries"
+		empty          = " "
+		target   int64 = 50000
+		negative int64 = -1
+	)
+
+	table := []struct {
+		Name    string
+		Payload category.PayloadCategory
+		Err     string
+	}{
+		{Name: "name", Payload: category.PayloadCategory{Name: &name}},
+		{Name: "goal target", Payload: category.PayloadCategory{GoalTarget: &target}},
+		{Name: "empty", Payload: category.PayloadCategory{}, Err: "category: payload has no field to update"},
+		{Name: "empty name", Payload: category.PayloadCategory{Name: &empty}, Err: "category: invalid category name"},
+		{
+			Name:    "negative goal target",
+			Payload: category.PayloadCategory{GoalTarget: &negative},
+			Err:     "category: goal target must not be negative",
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Payload.Validate()
+			if test.Err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.Err)
+		})
+	}
+}
+
+func TestPayloadCategory_JSON(t *testing.T) {
+	name := "Groceries"
+	group := "13419c12-78d3-4818-a5dc-601b2b8a6064"
+	buf, err := json.Marshal(category.PayloadCategory{Name: &name, CategoryGroupID: &group})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"Groceries","category_group_id":"13419c12-78d3-4818-a5dc-601b2b8a6064"}`, string(buf))
+}
@@ -72,6 +72,14 @@ func (s *Service) GetCategoryForMonth(ctx context.Context, budgetID string, cate
 	return s.getCategoryForMonth(ctx, budgetID, categoryID, api.DateFormat(month))
 }
 
+// GetCategoryAt fetches a specific category from a budget month
+// https://api.youneedabudget.com/v1#/Categories/getMonthCategoryById
+func (s *Service) GetCategoryAt(ctx context.Context, budgetID string, categoryID string,
+	month api.Month) (*Category, error) {
+
+	return s.getCategoryForMonth(ctx, budgetID, categoryID, month.String())
+}
+
 // GetCategoryForCurrentMonth fetches a specific category from the current budget month
 // https://api.youneedabudget.com/v1#/Categories/getMonthCategoryById
 func (s *Service) GetCategoryForCurrentMonth(ctx context.Context, budgetID string, categoryID string) (*Category, error) {
@@ -100,6 +108,14 @@ func (s *Service) UpdateCategoryForMonth(ctx context.Context, budgetID string, c
 	return s.updateCategoryForMonth(ctx, budgetID, categoryID, api.DateFormat(month), p)
 }
 
+// UpdateCategoryAt updates a category for a month
+// https://api.youneedabudget.com/v1#/Categories/updateMonthCategory
+func (s *Service) UpdateCategoryAt(ctx context.Context, budgetID string, categoryID string, month api.Month,
+	p PayloadMonthCategory) (*Category, error) {
+
+	return s.updateCategoryForMonth(ctx, budgetID, categoryID, month.String(), p)
+}
+
 // UpdateCategoryForCurrentMonth updates a category for the current month
 // https://api.youneedabudget.com/v1#/Categories/updateMonthCategory
 func (s *Service) UpdateCategoryForCurrentMonth(ctx context.Context, budgetID string, categoryID string,
@@ -111,6 +127,10 @@ func (s *Service) UpdateCategoryForCurrentMonth(ctx context.Context, budgetID st
 func (s *Service) updateCategoryForMonth(ctx context.Context, budgetID string, categoryID string, month string,
 	p PayloadMonthCategory) (*Category, error) {
 
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		MonthCategory *PayloadMonthCategory `json:"month_category"`
 	}{
@@ -136,3 +156,132 @@ func (s *Service) updateCategoryForMonth(ctx context.Context, budgetID string, c
 	}
 	return resModel.Data.Category, nil
 }
+
+// UpdateCategory updates the name, note, category group or goal target
+// of a category
+// https://api.youneedabudget.com/v1#/Categories/updateCategory
+func (s *Service) UpdateCategory(ctx context.Context, budgetID string, categoryID string,
+	p PayloadCategory) (*Category, error) {
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Category *PayloadCategory `json:"category"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Category *Category `json:"category"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/categories/%s", budgetID, categoryID)
+	if err := s.c.Patch(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Category, nil
+}
+
+// CreateCategory creates a new category in a category group
+// https://api.youneedabudget.com/v1#/Categories/createCategory
+func (s *Service) CreateCategory(ctx context.Context, budgetID string, p PayloadCategory) (*Category, error) {
+	if err := p.validateCreate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Category *PayloadCategory `json:"category"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Category *Category `json:"category"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/categories", budgetID)
+	if err := s.c.Post(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Category, nil
+}
+
+// CreateCategoryGroup creates a new category group
+// https://api.youneedabudget.com/v1#/Category_Groups/createCategoryGroup
+func (s *Service) CreateCategoryGroup(ctx context.Context, budgetID string, p PayloadCategoryGroup) (*Group, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		CategoryGroup *PayloadCategoryGroup `json:"category_group"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			CategoryGroup *Group `json:"category_group"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/category_groups", budgetID)
+	if err := s.c.Post(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.CategoryGroup, nil
+}
+
+// UpdateCategoryGroup updates the name of a category group
+// https://api.youneedabudget.com/v1#/Category_Groups/updateCategoryGroup
+func (s *Service) UpdateCategoryGroup(ctx context.Context, budgetID string, categoryGroupID string,
+	p PayloadCategoryGroup) (*Group, error) {
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		CategoryGroup *PayloadCategoryGroup `json:"category_group"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			CategoryGroup *Group `json:"category_group"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/category_groups/%s", budgetID, categoryGroupID)
+	if err := s.c.Patch(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.CategoryGroup, nil
+}
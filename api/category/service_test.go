@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
@@ -18,14 +19,8 @@ import (
 	"github.com/mellis/ynab.go/api/category"
 )
 
-func TestService_GetCategories(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getCategoriesResponse an API response served by TestService_GetCategories
+const getCategoriesResponse = `{
   "data": {
     "category_groups": [
       {
@@ -49,15 +44,30 @@ func TestService_GetCategories(t *testing.T) {
             "goal_creation_month": "2018-04-01",
             "goal_target": 18740,
             "goal_target_month": "2018-05-01",
-            "goal_percentage_complete": 20
+            "goal_percentage_complete": 20,
+            "goal_day": null,
+            "goal_cadence": null,
+            "goal_cadence_frequency": null,
+            "goal_under_funded": null,
+            "goal_overall_funded": null,
+            "goal_overall_left": null,
+            "goal_months_to_budget": null
           }
         ]
       }
-		],
-		"server_knowledge": 10
-	}
-}
-		`)
+    ],
+    "server_knowledge": 10
+  }
+}`
+
+func TestService_GetCategories(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getCategoriesResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -95,8 +105,8 @@ func TestService_GetCategories(t *testing.T) {
 						Balance:                int64(18740),
 						Deleted:                false,
 						GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-						GoalCreationMonth:      &expectedGoalCreationMonth,
-						GoalTargetMonth:        &expectedGoalTargetMonth,
+						GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+						GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 						GoalTarget:             &expectedGoalTarget,
 						GoalPercentageComplete: &expectedGoalPercentageComplete,
 					},
@@ -109,6 +119,36 @@ func TestService_GetCategories(t *testing.T) {
 	assert.Equal(t, expected, snapshot)
 }
 
+// getCategoryResponse an API response served by TestService_GetCategory
+const getCategoryResponse = `{
+  "data": {
+    "category": {
+      "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+      "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "MasterCard",
+      "hidden": false,
+      "original_category_group_id": null,
+      "note": null,
+      "budgeted": 0,
+      "activity": 12190,
+      "balance": 18740,
+      "deleted": false,
+      "goal_type": "TB",
+      "goal_creation_month": "2018-04-01",
+      "goal_target": 18740,
+      "goal_target_month": "2018-05-01",
+      "goal_percentage_complete": 20,
+      "goal_day": null,
+      "goal_cadence": null,
+      "goal_cadence_frequency": null,
+      "goal_under_funded": null,
+      "goal_overall_funded": null,
+      "goal_overall_left": null,
+      "goal_months_to_budget": null
+    }
+  }
+}`
+
 func TestService_GetCategory(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -116,28 +156,7 @@ func TestService_GetCategory(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "category": {
-			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
-			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
-			"name": "MasterCard",
-			"hidden": false,
-			"original_category_group_id": null,
-			"note": null,
-			"budgeted": 0,
-			"activity": 12190,
-			"balance": 18740,
-			"deleted": false,
-			"goal_type": "TB",
-			"goal_creation_month": "2018-04-01",
-			"goal_target": 18740,
-			"goal_target_month": "2018-05-01",
-			"goal_percentage_complete": 20
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getCategoryResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -170,8 +189,8 @@ func TestService_GetCategory(t *testing.T) {
 		Balance:                int64(18740),
 		Deleted:                false,
 		GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-		GoalCreationMonth:      &expectedGoalCreationMonth,
-		GoalTargetMonth:        &expectedGoalTargetMonth,
+		GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+		GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 		GoalTarget:             &expectedGoalTarget,
 		GoalPercentageComplete: &expectedGoalPercentageComplete,
 	}
@@ -185,28 +204,7 @@ func TestService_GetCategoryForMonth(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-01-01/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "category": {
-			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
-			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
-			"name": "MasterCard",
-			"hidden": false,
-			"original_category_group_id": null,
-			"note": null,
-			"budgeted": 0,
-			"activity": 12190,
-			"balance": 18740,
-			"deleted": false,
-			"goal_type": "TB",
-			"goal_creation_month": "2018-04-01",
-			"goal_target": 18740,
-			"goal_target_month": "2018-05-01",
-			"goal_percentage_complete": 20
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getCategoryResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -243,8 +241,8 @@ func TestService_GetCategoryForMonth(t *testing.T) {
 		Balance:                int64(18740),
 		Deleted:                false,
 		GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-		GoalCreationMonth:      &expectedGoalCreationMonth,
-		GoalTargetMonth:        &expectedGoalTargetMonth,
+		GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+		GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 		GoalTarget:             &expectedGoalTarget,
 		GoalPercentageComplete: &expectedGoalPercentageComplete,
 	}
@@ -258,28 +256,7 @@ func TestService_GetCategoryForCurrentMonth(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/current/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "category": {
-			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
-			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
-			"name": "MasterCard",
-			"hidden": false,
-			"original_category_group_id": null,
-			"note": null,
-			"budgeted": 0,
-			"activity": 12190,
-			"balance": 18740,
-			"deleted": false,
-			"goal_type": "TB",
-			"goal_creation_month": "2018-04-01",
-			"goal_target": 18740,
-			"goal_target_month": "2018-05-01",
-			"goal_percentage_complete": 20
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getCategoryResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -312,14 +289,44 @@ func TestService_GetCategoryForCurrentMonth(t *testing.T) {
 		Balance:                int64(18740),
 		Deleted:                false,
 		GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-		GoalCreationMonth:      &expectedGoalCreationMonth,
-		GoalTargetMonth:        &expectedGoalTargetMonth,
+		GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+		GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 		GoalTarget:             &expectedGoalTarget,
 		GoalPercentageComplete: &expectedGoalPercentageComplete,
 	}
 	assert.Equal(t, expected, c)
 }
 
+// updateCategoryForMonthResponse an API response served by TestService_UpdateCategoryForMonth
+const updateCategoryForMonthResponse = `{
+  "data": {
+    "category": {
+      "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+      "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "MasterCard",
+      "hidden": false,
+      "original_category_group_id": null,
+      "note": null,
+      "budgeted": 1000,
+      "activity": 12190,
+      "balance": 18740,
+      "deleted": false,
+      "goal_type": "TB",
+      "goal_creation_month": "2018-04-01",
+      "goal_target": 18740,
+      "goal_target_month": "2018-05-01",
+      "goal_percentage_complete": 20,
+      "goal_day": null,
+      "goal_cadence": null,
+      "goal_cadence_frequency": null,
+      "goal_under_funded": null,
+      "goal_overall_funded": null,
+      "goal_overall_left": null,
+      "goal_months_to_budget": null
+    }
+  }
+}`
+
 func TestService_UpdateCategoryForMonth(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -338,28 +345,7 @@ func TestService_UpdateCategoryForMonth(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, &payload, resModel.MonthCategory)
 
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "category": {
-			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
-			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
-			"name": "MasterCard",
-			"hidden": false,
-			"original_category_group_id": null,
-			"note": null,
-			"budgeted": 1000,
-			"activity": 12190,
-			"balance": 18740,
-			"deleted": false,
-			"goal_type": "TB",
-			"goal_creation_month": "2018-04-01",
-			"goal_target": 18740,
-			"goal_target_month": "2018-05-01",
-			"goal_percentage_complete": 20
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, updateCategoryForMonthResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -394,8 +380,8 @@ func TestService_UpdateCategoryForMonth(t *testing.T) {
 		Balance:                int64(18740),
 		Deleted:                false,
 		GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-		GoalCreationMonth:      &expectedGoalCreationMonth,
-		GoalTargetMonth:        &expectedGoalTargetMonth,
+		GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+		GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 		GoalTarget:             &expectedGoalTarget,
 		GoalPercentageComplete: &expectedGoalPercentageComplete,
 	}
@@ -420,28 +406,7 @@ func TestService_UpdateCategoryForCurrentMonth(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, &payload, resModel.MonthCategory)
 
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "category": {
-			"id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
-			"category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
-			"name": "MasterCard",
-			"hidden": false,
-			"original_category_group_id": null,
-			"note": null,
-			"budgeted": 1000,
-			"activity": 12190,
-			"balance": 18740,
-			"deleted": false,
-			"goal_type": "TB",
-			"goal_creation_month": "2018-04-01",
-			"goal_target": 18740,
-			"goal_target_month": "2018-05-01",
-			"goal_percentage_complete": 20
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, updateCategoryForMonthResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -475,10 +440,346 @@ func TestService_UpdateCategoryForCurrentMonth(t *testing.T) {
 		Balance:                int64(18740),
 		Deleted:                false,
 		GoalType:               category.GoalTargetCategoryBalance.Pointer(),
-		GoalCreationMonth:      &expectedGoalCreationMonth,
-		GoalTargetMonth:        &expectedGoalTargetMonth,
+		GoalCreationMonth:      api.NewNullDate(expectedGoalCreationMonth),
+		GoalTargetMonth:        api.NewNullDate(expectedGoalTargetMonth),
 		GoalTarget:             &expectedGoalTarget,
 		GoalPercentageComplete: &expectedGoalPercentageComplete,
 	}
 	assert.Equal(t, expected, c)
 }
+
+// getCategoryAtResponse an API response served by TestService_GetCategoryAt
+const getCategoryAtResponse = `{
+  "data": {
+    "category": {
+      "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+      "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "MasterCard",
+      "budgeted": 1000,
+      "hidden": false,
+      "activity": 0,
+      "balance": 0,
+      "deleted": false,
+      "note": null,
+      "original_category_group_id": null,
+      "goal_type": null,
+      "goal_creation_month": null,
+      "goal_target": null,
+      "goal_target_month": null,
+      "goal_percentage_complete": null,
+      "goal_day": null,
+      "goal_cadence": null,
+      "goal_cadence_frequency": null,
+      "goal_under_funded": null,
+      "goal_overall_funded": null,
+      "goal_overall_left": null,
+      "goal_months_to_budget": null
+    }
+  }
+}`
+
+func TestService_GetCategoryAt(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-05-01/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getCategoryAtResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().GetCategoryAt(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		api.NewMonth(2018, time.May),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "MasterCard", c.Name)
+	assert.Equal(t, int64(1000), c.Budgeted)
+}
+
+func TestService_UpdateCategoryAt(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payload := category.PayloadMonthCategory{
+		Budgeted: 1000,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2018-05-01/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				MonthCategory *category.PayloadMonthCategory `json:"month_category"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, resModel.MonthCategory)
+
+			res := httpmock.NewStringResponse(200, getCategoryAtResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().UpdateCategoryAt(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		api.NewMonth(2018, time.May),
+		payload,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), c.Budgeted)
+}
+
+// updateCategoryResponse an API response served by TestService_UpdateCategory
+const updateCategoryResponse = `{
+  "data": {
+    "category": {
+      "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+      "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "Groceries",
+      "note": "weekly shopping",
+      "budgeted": 0,
+      "goal_type": "TB",
+      "goal_target": 400000,
+      "hidden": false,
+      "activity": 0,
+      "balance": 0,
+      "deleted": false,
+      "original_category_group_id": null,
+      "goal_creation_month": null,
+      "goal_target_month": null,
+      "goal_percentage_complete": null,
+      "goal_day": null,
+      "goal_cadence": null,
+      "goal_cadence_frequency": null,
+      "goal_under_funded": null,
+      "goal_overall_funded": null,
+      "goal_overall_left": null,
+      "goal_months_to_budget": null
+    },
+    "server_knowledge": 12
+  }
+}`
+
+func TestService_UpdateCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var (
+		name       = "Groceries"
+		note       = "weekly shopping"
+		goalTarget = int64(400000)
+	)
+	payload := category.PayloadCategory{
+		Name:       &name,
+		Note:       &note,
+		GoalTarget: &goalTarget,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/13419c12-78d3-4a26-82ca-1cde7aa1d6f8"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Category *category.PayloadCategory `json:"category"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, resModel.Category)
+
+			res := httpmock.NewStringResponse(200, updateCategoryResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().UpdateCategory(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		payload,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "Groceries", c.Name)
+	assert.Equal(t, &note, c.Note)
+	assert.Equal(t, &goalTarget, c.GoalTarget)
+}
+
+func TestService_UpdateCategoryForMonth_invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	goalTarget := int64(-1)
+	client := ynab.NewClient("")
+	_, err := client.Category().UpdateCategoryAt(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+		api.NewMonth(2018, time.May),
+		category.PayloadMonthCategory{Budgeted: 1000, GoalTarget: &goalTarget},
+	)
+	assert.EqualError(t, err, "category: goal target must not be negative")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+// createCategoryResponse an API response served by TestService_CreateCategory
+const createCategoryResponse = `{
+  "data": {
+    "category": {
+      "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+      "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+      "name": "Groceries",
+      "hidden": false,
+      "budgeted": 0,
+      "activity": 0,
+      "balance": 0,
+      "deleted": false,
+      "note": null,
+      "original_category_group_id": null,
+      "goal_type": null,
+      "goal_creation_month": null,
+      "goal_target": null,
+      "goal_target_month": null,
+      "goal_percentage_complete": null,
+      "goal_day": null,
+      "goal_cadence": null,
+      "goal_cadence_frequency": null,
+      "goal_under_funded": null,
+      "goal_overall_funded": null,
+      "goal_overall_left": null,
+      "goal_months_to_budget": null
+    },
+    "server_knowledge": 12
+  }
+}`
+
+func TestService_CreateCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var (
+		name    = "Groceries"
+		groupID = "13419c12-78d3-4818-a5dc-601b2b8a6064"
+	)
+	payload := category.PayloadCategory{Name: &name, CategoryGroupID: &groupID}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Category *category.PayloadCategory `json:"category"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, resModel.Category)
+
+			res := httpmock.NewStringResponse(http.StatusCreated, createCategoryResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	c, err := client.Category().CreateCategory(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "13419c12-78d3-4a26-82ca-1cde7aa1d6f8", c.ID)
+	assert.Equal(t, groupID, c.CategoryGroupID)
+
+	_, err = client.Category().CreateCategory(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		category.PayloadCategory{Name: &name})
+	assert.EqualError(t, err, "category: category group is required")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+// createCategoryGroupResponse an API response served by TestService_CreateCategoryGroup
+const createCategoryGroupResponse = `{
+  "data": {
+    "category_group": {
+			"id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+			"name": "Bills",
+			"hidden": false,
+			"deleted": false
+    },
+		"server_knowledge": 12
+	}
+}`
+
+func TestService_CreateCategoryGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/category_groups"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				CategoryGroup *category.PayloadCategoryGroup `json:"category_group"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &category.PayloadCategoryGroup{Name: "Bills"}, resModel.CategoryGroup)
+
+			res := httpmock.NewStringResponse(http.StatusCreated, createCategoryGroupResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	g, err := client.Category().CreateCategoryGroup(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		category.PayloadCategoryGroup{Name: "Bills"})
+	assert.NoError(t, err)
+	assert.Equal(t, &category.Group{ID: "13419c12-78d3-4818-a5dc-601b2b8a6064", Name: "Bills"}, g)
+}
+
+// updateCategoryGroupResponse an API response served by TestService_UpdateCategoryGroup
+const updateCategoryGroupResponse = `{
+  "data": {
+    "category_group": {
+			"id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+			"name": "Monthly Bills",
+			"hidden": false,
+			"deleted": false
+    },
+		"server_knowledge": 13
+	}
+}`
+
+func TestService_UpdateCategoryGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/category_groups/13419c12-78d3-4818-a5dc-601b2b8a6064"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				CategoryGroup *category.PayloadCategoryGroup `json:"category_group"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &category.PayloadCategoryGroup{Name: "Monthly Bills"}, resModel.CategoryGroup)
+
+			res := httpmock.NewStringResponse(http.StatusOK, updateCategoryGroupResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	g, err := client.Category().UpdateCategoryGroup(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4818-a5dc-601b2b8a6064", category.PayloadCategoryGroup{Name: "Monthly Bills"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Monthly Bills", g.Name)
+
+	_, err = client.Category().UpdateCategoryGroup(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		"13419c12-78d3-4818-a5dc-601b2b8a6064", category.PayloadCategoryGroup{})
+	assert.EqualError(t, err, "category: invalid category group name")
+}
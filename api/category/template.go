@@ -0,0 +1,100 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category
+
+import (
+	"context"
+	"strings"
+)
+
+// Template represents a desired category structure for a budget
+type Template struct {
+	Groups []TemplateGroup
+}
+
+// TemplateGroup represents a desired category group and its categories
+type TemplateGroup struct {
+	Name       string
+	Categories []TemplateCategory
+}
+
+// TemplateCategory represents a desired category. Note and GoalTarget are
+// only used when the category is created
+type TemplateCategory struct {
+	Name       string
+	Note       *string
+	GoalTarget *int64
+}
+
+// TemplateResult represents what applying a template created
+type TemplateResult struct {
+	Groups     []*Group
+	Categories []*Category
+}
+
+// ApplyTemplate creates the category groups and categories of a template
+// missing from a budget. Groups and categories are matched by name,
+// ignoring case and surrounding spaces, and existing ones are left
+// untouched, so applying the same template twice creates nothing. Deleted
+// groups and categories are considered missing
+func (s *Service) ApplyTemplate(ctx context.Context, budgetID string, t Template) (*TemplateResult, error) {
+	snapshot, err := s.GetCategories(ctx, budgetID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*GroupWithCategories)
+	for _, g := range snapshot.GroupWithCategories {
+		if !g.Deleted {
+			groups[templateKey(g.Name)] = g
+		}
+	}
+
+	result := &TemplateResult{}
+	for _, tg := range t.Groups {
+		g, ok := groups[templateKey(tg.Name)]
+		if !ok {
+			created, err := s.CreateCategoryGroup(ctx, budgetID, PayloadCategoryGroup{Name: tg.Name})
+			if err != nil {
+				return result, err
+			}
+			result.Groups = append(result.Groups, created)
+			g = &GroupWithCategories{ID: created.ID, Name: created.Name}
+			groups[templateKey(tg.Name)] = g
+		}
+
+		existing := make(map[string]bool)
+		for _, c := range g.Categories {
+			if !c.Deleted {
+				existing[templateKey(c.Name)] = true
+			}
+		}
+
+		for _, tc := range tg.Categories {
+			if existing[templateKey(tc.Name)] {
+				continue
+			}
+
+			name := tc.Name
+			c, err := s.CreateCategory(ctx, budgetID, PayloadCategory{
+				Name:            &name,
+				Note:            tc.Note,
+				CategoryGroupID: &g.ID,
+				GoalTarget:      tc.GoalTarget,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.Categories = append(result.Categories, c)
+			g.Categories = append(g.Categories, c)
+			existing[templateKey(tc.Name)] = true
+		}
+	}
+	return result, nil
+}
+
+func templateKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package category_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api/category"
+)
+
+func TestService_ApplyTemplate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	budgetURL := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+	httpmock.RegisterResponder(http.MethodGet, budgetURL+"/categories",
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusOK, `{"data":{"server_knowledge":10,"category_groups":[
+				{"id":"grp-1","name":"Bills","categories":[
+					{"id":"cat-1","category_group_id":"grp-1","name":"Rent"},
+					{"id":"cat-2","category_group_id":"grp-1","name":"Internet","deleted":true}
+				]},
+				{"id":"grp-2","name":"Savings","deleted":true,"categories":[]}
+			]}}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	var createdGroups []string
+	httpmock.RegisterResponder(http.MethodPost, budgetURL+"/category_groups",
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				CategoryGroup *category.PayloadCategoryGroup `json:"category_group"`
+			}{}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&resModel))
+			createdGroups = append(createdGroups, resModel.CategoryGroup.Name)
+
+			res := httpmock.NewStringResponse(http.StatusCreated, fmt.Sprintf(
+				`{"data":{"category_group":{"id":"grp-new","name":%q}}}`, resModel.CategoryGroup.Name))
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	var createdCategories []string
+	httpmock.RegisterResponder(http.MethodPost, budgetURL+"/categories",
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Category *category.PayloadCategory `json:"category"`
+			}{}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&resModel))
+			c := resModel.Category
+			createdCategories = append(createdCategories, *c.CategoryGroupID+"/"+*c.Name)
+
+			res := httpmock.NewStringResponse(http.StatusCreated, fmt.Sprintf(
+				`{"data":{"category":{"id":"cat-new","category_group_id":%q,"name":%q}}}`, *c.CategoryGroupID, *c.Name))
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	goalTarget := int64(100000)
+	tmpl := category.Template{
+		Groups: []category.TemplateGroup{
+			{
+				Name: " bills",
+				Categories: []category.TemplateCategory{
+					{Name: "Rent"},
+					{Name: "Internet"},
+					{Name: "Electricity"},
+				},
+			},
+			{
+				Name: "Savings",
+				Categories: []category.TemplateCategory{
+					{Name: "Emergency fund", GoalTarget: &goalTarget},
+				},
+			},
+			{
+				Name: "Savings",
+				Categories: []category.TemplateCategory{
+					{Name: "emergency fund"},
+				},
+			},
+		},
+	}
+
+	client := ynab.NewClient("")
+	r, err := client.Category().ApplyTemplate(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", tmpl)
+	assert.NoError(t, err)
+	assert.Len(t, r.Groups, 1)
+	assert.Len(t, r.Categories, 3)
+	assert.Equal(t, []string{"Savings"}, createdGroups)
+	assert.Equal(t, []string{
+		"grp-1/Internet",
+		"grp-1/Electricity",
+		"grp-new/Emergency fund",
+	}, createdCategories)
+}
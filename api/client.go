@@ -18,6 +18,7 @@ type ClientWriter interface {
 	Post(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
 	Put(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
 	Patch(ctx context.Context, url string, responseModel interface{}, requestBody []byte) error
+	Delete(ctx context.Context, url string, responseModel interface{}) error
 }
 
 // ClientReaderWriter contract for a read-write client
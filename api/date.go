@@ -18,8 +18,13 @@ type Date struct {
 	time.Time
 }
 
-// UnmarshalJSON parses the expected format for a Date
+// UnmarshalJSON parses the expected format for a Date. A JSON null
+// leaves the date unchanged
 func (d *Date) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
 	// b value comes in surrounded by quotes
 	s := strings.Trim(string(b), "\"")
 
@@ -33,11 +38,46 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 }
 
 // MarshalJSON parses the expected format for a Date
-func (d *Date) MarshalJSON() ([]byte, error) {
+func (d Date) MarshalJSON() ([]byte, error) {
 	val := d.Format(dateLayout)
 	return []byte(fmt.Sprintf(`"%s"`, val)), nil
 }
 
+// NullDate represents a budget date which may be null. Valid is false
+// when the date is null
+type NullDate struct {
+	Date
+	Valid bool
+}
+
+// NewNullDate creates a new valid NullDate from a given Date
+func NewNullDate(d Date) NullDate {
+	return NullDate{Date: d, Valid: true}
+}
+
+// UnmarshalJSON parses the expected format for a Date, or a JSON null
+func (d *NullDate) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*d = NullDate{}
+		return nil
+	}
+
+	if err := d.Date.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON formats the date following the expected format for a
+// Date, or as a JSON null when the date is not valid
+func (d NullDate) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return d.Date.MarshalJSON()
+}
+
 // DateFromString creates a new Date from a given string date
 // formatted as dateLayout
 func DateFromString(s string) (Date, error) {
@@ -56,3 +96,75 @@ func DateFromString(s string) (Date, error) {
 func DateFormat(date Date) string {
 	return date.Format(dateLayout)
 }
+
+// dateFormatTokens the YNAB date format tokens and their Go layout
+// equivalents, longer tokens first
+var dateFormatTokens = []struct {
+	token  string
+	layout string
+}{
+	{"YYYY", "2006"},
+	{"YY", "06"},
+	{"MMMM", "January"},
+	{"MMM", "Jan"},
+	{"MM", "01"},
+	{"M", "1"},
+	{"DD", "02"},
+	{"D", "2"},
+}
+
+// DateLayouter contract for a date format setting which can be expressed
+// as a Go time layout, such as a budget date format
+type DateLayouter interface {
+	Layout() string
+}
+
+// DateLayout translates a YNAB date format such as "DD.MM.YYYY" into the
+// equivalent Go time layout, "02.01.2006". Characters other than the
+// YYYY, YY, MMMM, MMM, MM, M, DD and D tokens are kept as they are
+func DateLayout(format string) string {
+	var b strings.Builder
+	for len(format) > 0 {
+		matched := false
+		for _, t := range dateFormatTokens {
+			if strings.HasPrefix(format, t.token) {
+				b.WriteString(t.layout)
+				format = format[len(t.token):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteByte(format[0])
+			format = format[1:]
+		}
+	}
+	return b.String()
+}
+
+// FormatFor formats the date following a date format setting, falling
+// back to YYYY-MM-DD when l is nil or has no layout
+func (d Date) FormatFor(l DateLayouter) string {
+	return d.Format(layoutFor(l))
+}
+
+// ParseDateFor creates a new Date from a given string date formatted
+// following a date format setting, falling back to YYYY-MM-DD when l is
+// nil or has no layout
+func ParseDateFor(l DateLayouter, s string) (Date, error) {
+	t, err := time.Parse(layoutFor(l), s)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{Time: t}, nil
+}
+
+func layoutFor(l DateLayouter) string {
+	if l == nil {
+		return dateLayout
+	}
+	if layout := l.Layout(); layout != "" {
+		return layout
+	}
+	return dateLayout
+}
@@ -101,3 +101,108 @@ func TestDateFormat(t *testing.T) {
 		assert.Equal(t, test.OutputFormattedDate, formattedDate)
 	}
 }
+
+// dateFormat is a DateLayouter for a YNAB date format
+type dateFormat string
+
+func (f dateFormat) Layout() string {
+	return api.DateLayout(string(f))
+}
+
+func TestDateLayout(t *testing.T) {
+	table := map[string]string{
+		"YYYY-MM-DD":   "2006-01-02",
+		"DD.MM.YYYY":   "02.01.2006",
+		"MM/DD/YYYY":   "01/02/2006",
+		"YYYY/MM/DD":   "2006/01/02",
+		"D/M/YY":       "2/1/06",
+		"DD MMM YYYY":  "02 Jan 2006",
+		"MMMM D, YYYY": "January 2, 2006",
+		"":             "",
+	}
+	for format, layout := range table {
+		assert.Equal(t, layout, api.DateLayout(format), format)
+	}
+}
+
+func TestDate_FormatFor(t *testing.T) {
+	date, err := api.DateFromString("2020-03-05")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "05.03.2020", date.FormatFor(dateFormat("DD.MM.YYYY")))
+	assert.Equal(t, "03/05/2020", date.FormatFor(dateFormat("MM/DD/YYYY")))
+	assert.Equal(t, "5/3/20", date.FormatFor(dateFormat("D/M/YY")))
+	assert.Equal(t, "2020-03-05", date.FormatFor(dateFormat("")))
+	assert.Equal(t, "2020-03-05", date.FormatFor(nil))
+}
+
+func TestParseDateFor(t *testing.T) {
+	expected, err := api.DateFromString("2020-03-05")
+	assert.NoError(t, err)
+
+	date, err := api.ParseDateFor(dateFormat("DD.MM.YYYY"), "05.03.2020")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, date)
+
+	date, err = api.ParseDateFor(dateFormat("MM/DD/YYYY"), "03/05/2020")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, date)
+
+	date, err = api.ParseDateFor(nil, "2020-03-05")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, date)
+
+	_, err = api.ParseDateFor(dateFormat("DD.MM.YYYY"), "2020-03-05")
+	assert.Error(t, err)
+}
+
+func TestDate_null(t *testing.T) {
+	wrapper := struct {
+		Date api.Date
+	}{}
+
+	err := json.Unmarshal([]byte(`{"Date": null}`), &wrapper)
+	assert.NoError(t, err)
+	assert.True(t, wrapper.Date.IsZero())
+
+	// marshalled by value
+	buf, err := json.Marshal(wrapper)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Date":"0001-01-01"}`, string(buf))
+}
+
+func TestNullDate_JSON(t *testing.T) {
+	type wrapper struct {
+		Date api.NullDate
+	}
+
+	t.Run("date", func(t *testing.T) {
+		w := wrapper{}
+		err := json.Unmarshal([]byte(`{"Date": "2020-01-20"}`), &w)
+		assert.NoError(t, err)
+		assert.True(t, w.Date.Valid)
+		assert.Equal(t, "2020-01-20", api.DateFormat(w.Date.Date))
+
+		buf, err := json.Marshal(w)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"Date":"2020-01-20"}`, string(buf))
+	})
+
+	t.Run("null", func(t *testing.T) {
+		w := wrapper{Date: api.NewNullDate(api.Date{})}
+		err := json.Unmarshal([]byte(`{"Date": null}`), &w)
+		assert.NoError(t, err)
+		assert.False(t, w.Date.Valid)
+
+		buf, err := json.Marshal(w)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"Date":null}`, string(buf))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		w := wrapper{}
+		err := json.Unmarshal([]byte(`{"Date": "2020-13-20"}`), &w)
+		assert.Error(t, err)
+		assert.False(t, w.Date.Valid)
+	})
+}
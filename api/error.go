@@ -5,7 +5,35 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Sentinel errors matched by an *Error through errors.Is, according to
+// its HTTP status code
+// https://api.youneedabudget.com/#errors
+var (
+	// ErrBadRequest the request was malformed or failed validation
+	ErrBadRequest = errors.New("api: bad request")
+	// ErrUnauthorized the access token is missing, invalid, revoked or expired
+	ErrUnauthorized = errors.New("api: unauthorized")
+	// ErrForbidden the subscription lapsed, the trial expired, the token
+	// scope is not authorized or the data limit was reached
+	ErrForbidden = errors.New("api: forbidden")
+	// ErrNotFound the requested resource or endpoint was not found
+	ErrNotFound = errors.New("api: not found")
+	// ErrConflict the resource cannot be saved because it conflicts with
+	// an existing one
+	ErrConflict = errors.New("api: conflict")
+	// ErrRateLimited the rate limit of the access token was exceeded
+	ErrRateLimited = errors.New("api: rate limited")
+	// ErrInternal the API raised an unexpected error
+	ErrInternal = errors.New("api: internal server error")
+	// ErrUnavailable the API is temporarily unavailable
+	ErrUnavailable = errors.New("api: service unavailable")
 )
 
 // Error represents an API Error
@@ -13,6 +41,18 @@ type Error struct {
 	ID     string `json:"id"`
 	Name   string `json:"name"`
 	Detail string `json:"detail"`
+
+	// StatusCode the HTTP status code of the response
+	StatusCode int `json:"-"`
+	// Method the HTTP method of the request
+	Method string `json:"-"`
+	// Path the API path of the request as sent, with the actual IDs and
+	// the query, e.g. /budgets/aa248caa/transactions?since_date=2018-01-01
+	Path string `json:"-"`
+	// Body the raw response body
+	Body []byte `json:"-"`
+	// RateLimit the rate limit returned along with the error, if any
+	RateLimit *RateLimit `json:"-"`
 }
 
 // Error returns the string version of the error
@@ -20,3 +60,39 @@ func (e Error) Error() string {
 	return fmt.Sprintf("api: error id=%s name=%s detail=%s",
 		e.ID, e.Name, e.Detail)
 }
+
+// Is reports whether the error matches the given sentinel error,
+// e.g. errors.Is(err, api.ErrNotFound)
+func (e Error) Is(target error) bool {
+	return target != nil && e.sentinel() == target
+}
+
+// sentinel returns the sentinel error for the error status code, falling
+// back to the status code prefix of the error ID, e.g. 404 for "404.2"
+func (e Error) sentinel() error {
+	status := e.StatusCode
+	if status == 0 {
+		code, _, _ := strings.Cut(e.ID, ".")
+		status, _ = strconv.Atoi(code)
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		return ErrBadRequest
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusInternalServerError:
+		return ErrInternal
+	case http.StatusServiceUnavailable:
+		return ErrUnavailable
+	}
+	return nil
+}
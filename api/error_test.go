@@ -0,0 +1,52 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+func TestError_Is(t *testing.T) {
+	table := []struct {
+		In  *api.Error
+		Out error
+	}{
+		{&api.Error{ID: "400", StatusCode: 400}, api.ErrBadRequest},
+		{&api.Error{ID: "401", StatusCode: 401}, api.ErrUnauthorized},
+		{&api.Error{ID: "403.1", StatusCode: 403}, api.ErrForbidden},
+		{&api.Error{ID: "404.2", StatusCode: 404}, api.ErrNotFound},
+		{&api.Error{ID: "409", StatusCode: 409}, api.ErrConflict},
+		{&api.Error{ID: "429", StatusCode: 429}, api.ErrRateLimited},
+		{&api.Error{ID: "500", StatusCode: 500}, api.ErrInternal},
+		{&api.Error{ID: "503", StatusCode: 503}, api.ErrUnavailable},
+		{&api.Error{ID: "404.1"}, api.ErrNotFound},
+		{&api.Error{ID: "403.4"}, api.ErrForbidden},
+	}
+
+	sentinels := []error{
+		api.ErrBadRequest, api.ErrUnauthorized, api.ErrForbidden, api.ErrNotFound,
+		api.ErrConflict, api.ErrRateLimited, api.ErrInternal, api.ErrUnavailable,
+	}
+
+	for _, test := range table {
+		err := fmt.Errorf("wrapped: %w", test.In)
+		for _, sentinel := range sentinels {
+			assert.Equal(t, sentinel == test.Out, errors.Is(err, sentinel), "%s %s", test.In.ID, sentinel)
+		}
+
+		var apiErr *api.Error
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, test.In, apiErr)
+	}
+
+	assert.False(t, errors.Is(&api.Error{ID: "418", StatusCode: 418}, nil))
+	assert.False(t, errors.Is(&api.Error{ID: "unknown"}, api.ErrNotFound))
+}
@@ -23,3 +23,10 @@ func ExampleParseRateLimit() {
 
 	// Output: 1
 }
+
+func ExampleParseMilliunits() {
+	m, _ := api.ParseMilliunits("-1,234.56")
+	fmt.Println(int64(m), m)
+
+	// Output: -1234560 -1234.56
+}
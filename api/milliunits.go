@@ -0,0 +1,203 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MilliunitsPerUnit the number of milliunits in one currency unit
+const MilliunitsPerUnit = 1000
+
+var (
+	errInvalidMilliunits   = errors.New(`api: invalid milliunits string`)
+	errMilliunitsPrecision = errors.New(`api: amount has more than 3 decimal digits`)
+	errMilliunitsRange     = errors.New(`api: amount out of milliunits range`)
+)
+
+// Milliunits represents an amount in milliunits of a currency, the
+// format used by the API for every amount: 1234560 is 1,234.56 in a
+// two decimal digits currency
+type Milliunits int64
+
+// ParseMilliunits parses a decimal amount such as "-1,234.56" into
+// milliunits. The decimal separator is "." and "," may be used to group
+// digits. The conversion is exact, so amounts with more than 3 decimal
+// digits are rejected
+func ParseMilliunits(s string) (Milliunits, error) {
+	s = strings.TrimSpace(s)
+
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	whole = strings.ReplaceAll(whole, ",", "")
+
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, errInvalidMilliunits
+	}
+	if len(strings.TrimRight(frac, "0")) > 3 {
+		return 0, errMilliunitsPrecision
+	}
+	frac = (frac + "000")[:3]
+
+	var units uint64
+	if whole != "" {
+		var err error
+		if units, err = strconv.ParseUint(whole, 10, 64); err != nil {
+			return 0, errMilliunitsRange
+		}
+	}
+	milli, _ := strconv.ParseUint(frac, 10, 64)
+
+	if units > (math.MaxInt64-milli)/MilliunitsPerUnit {
+		return 0, errMilliunitsRange
+	}
+	m := Milliunits(units*MilliunitsPerUnit + milli)
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns the sum of m and n
+func (m Milliunits) Add(n Milliunits) Milliunits {
+	return m + n
+}
+
+// Sub returns the difference of m and n
+func (m Milliunits) Sub(n Milliunits) Milliunits {
+	return m - n
+}
+
+// Mul returns m multiplied by n
+func (m Milliunits) Mul(n int64) Milliunits {
+	return m * Milliunits(n)
+}
+
+// Neg returns m with the opposite sign
+func (m Milliunits) Neg() Milliunits {
+	return -m
+}
+
+// Abs returns the absolute value of m
+func (m Milliunits) Abs() Milliunits {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// Split divides m into n parts which differ by at most one milliunit and
+// sum exactly to m, the larger parts first
+func (m Milliunits) Split(n int) []Milliunits {
+	if n <= 0 {
+		return nil
+	}
+
+	parts := make([]Milliunits, n)
+	q, r := m/Milliunits(n), m%Milliunits(n)
+	for i := range parts {
+		parts[i] = q
+	}
+
+	step := Milliunits(1)
+	if r < 0 {
+		step, r = -1, -r
+	}
+	for i := Milliunits(0); i < r; i++ {
+		parts[i] += step
+	}
+	return parts
+}
+
+// Round rounds m to the given number of decimal digits, up to 3, rounding
+// half away from zero
+func (m Milliunits) Round(digits int) Milliunits {
+	if digits >= 3 {
+		return m
+	}
+	if digits < 0 {
+		digits = 0
+	}
+
+	unit := Milliunits(1)
+	for i := digits; i < 3; i++ {
+		unit *= 10
+	}
+	q := (m.Abs() + unit/2) / unit * unit
+	if m < 0 {
+		return -q
+	}
+	return q
+}
+
+// Int64 returns m as a raw milliunits amount
+func (m Milliunits) Int64() int64 {
+	return int64(m)
+}
+
+// Decimal returns m as a decimal string with exactly the given number of
+// decimal digits, up to 3, rounding half away from zero
+func (m Milliunits) Decimal(digits int) string {
+	if digits > 3 {
+		digits = 3
+	}
+	if digits < 0 {
+		digits = 0
+	}
+
+	whole, frac := m.Round(digits).parts()
+	if digits == 0 {
+		return whole
+	}
+	return whole + "." + frac[:digits]
+}
+
+// String returns m as the shortest exact decimal string, such as
+// "-1234.56" for -1234560 milliunits
+func (m Milliunits) String() string {
+	whole, frac := m.parts()
+	frac = strings.TrimRight(frac, "0")
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+// parts returns the signed whole units and the 3 fractional digits of m
+func (m Milliunits) parts() (string, string) {
+	u := uint64(m)
+	sign := ""
+	if m < 0 {
+		u = uint64(-m)
+		sign = "-"
+	}
+
+	frac := strconv.FormatUint(u%MilliunitsPerUnit, 10)
+	frac = strings.Repeat("0", 3-len(frac)) + frac
+	return sign + strconv.FormatUint(u/MilliunitsPerUnit, 10), frac
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+func TestParseMilliunits(t *testing.T) {
+	table := []struct {
+		input    string
+		expected api.Milliunits
+	}{
+		{"0", 0},
+		{"1", 1000},
+		{"-1,234.56", -1234560},
+		{"+1234.5", 1234500},
+		{" 0.001 ", 1},
+		{".5", 500},
+		{"12.", 12000},
+		{"1.2300", 1230},
+		{"9223372036854775.807", math.MaxInt64},
+	}
+	for _, test := range table {
+		t.Run(test.input, func(t *testing.T) {
+			m, err := api.ParseMilliunits(test.input)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, m)
+		})
+	}
+
+	for _, input := range []string{"", "-", ".", "abc", "1.2.3", "1e3", "$1", "1.0001", "9223372036854775.808"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := api.ParseMilliunits(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMilliunits_String(t *testing.T) {
+	table := map[api.Milliunits]string{
+		0:             "0",
+		1:             "0.001",
+		-1234560:      "-1234.56",
+		1000:          "1",
+		-5:            "-0.005",
+		math.MinInt64: "-9223372036854775.808",
+	}
+	for m, expected := range table {
+		assert.Equal(t, expected, m.String())
+	}
+}
+
+func TestMilliunits_Decimal(t *testing.T) {
+	m := api.Milliunits(-1234565)
+	assert.Equal(t, "-1235", m.Decimal(0))
+	assert.Equal(t, "-1234.57", m.Decimal(2))
+	assert.Equal(t, "-1234.565", m.Decimal(3))
+	assert.Equal(t, "0.00", api.Milliunits(-4).Decimal(2))
+}
+
+func TestMilliunits_arithmetic(t *testing.T) {
+	m := api.Milliunits(10000)
+	assert.Equal(t, api.Milliunits(12500), m.Add(2500))
+	assert.Equal(t, api.Milliunits(7500), m.Sub(2500))
+	assert.Equal(t, api.Milliunits(-30000), m.Mul(-3))
+	assert.Equal(t, api.Milliunits(-10000), m.Neg())
+	assert.Equal(t, m, m.Neg().Abs())
+	assert.Equal(t, int64(10000), m.Int64())
+
+	assert.Equal(t, api.Milliunits(1000), api.Milliunits(1499).Round(0))
+	assert.Equal(t, api.Milliunits(-2000), api.Milliunits(-1500).Round(0))
+	assert.Equal(t, api.Milliunits(1230), api.Milliunits(1234).Round(2))
+}
+
+func TestMilliunits_Split(t *testing.T) {
+	assert.Equal(t, []api.Milliunits{3334, 3333, 3333}, api.Milliunits(10000).Split(3))
+	assert.Equal(t, []api.Milliunits{-3334, -3333, -3333}, api.Milliunits(-10000).Split(3))
+	assert.Nil(t, api.Milliunits(10000).Split(0))
+}
+
+func TestMilliunits_JSON(t *testing.T) {
+	wrapper := struct {
+		Amount api.Milliunits `json:"amount"`
+	}{}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":-1234560}`), &wrapper))
+	assert.Equal(t, api.Milliunits(-1234560), wrapper.Amount)
+
+	buf, err := json.Marshal(wrapper)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":-1234560}`, string(buf))
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// monthLayout expected layout format for the Month type
+const monthLayout = "2006-01"
+
+var errInvalidMonth = errors.New(`api: invalid month string, expected YYYY-MM or YYYY-MM-01`)
+
+// Month represents a budget month, identified by a year and a month
+type Month struct {
+	year  int
+	month time.Month
+}
+
+// NewMonth creates a new Month, normalising months out of the 1-12 range
+// such as month 13 becoming January of the next year
+func NewMonth(year int, month time.Month) Month {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return Month{year: t.Year(), month: t.Month()}
+}
+
+// MonthOf returns the month of a given time
+func MonthOf(t time.Time) Month {
+	return Month{year: t.Year(), month: t.Month()}
+}
+
+// CurrentMonth returns the current month in the given location, which
+// defaults to UTC when nil
+func CurrentMonth(loc *time.Location) Month {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return MonthOf(time.Now().In(loc))
+}
+
+// MonthFromString creates a new Month from a given string formatted as
+// YYYY-MM or as the first day of the month, YYYY-MM-01
+func MonthFromString(s string) (Month, error) {
+	if len(s) == len(dateLayout) {
+		if !strings.HasSuffix(s, "-01") {
+			return Month{}, errInvalidMonth
+		}
+		s = s[:len(monthLayout)]
+	}
+
+	t, err := time.Parse(monthLayout, s)
+	if err != nil {
+		return Month{}, errInvalidMonth
+	}
+	return MonthOf(t), nil
+}
+
+// MonthRange returns the months from one month to another, both included.
+// The range is empty when to is before from
+func MonthRange(from, to Month) []Month {
+	var months []Month
+	for m := from; !m.After(to); m = m.Next() {
+		months = append(months, m)
+	}
+	return months
+}
+
+// Year returns the year of the month
+func (m Month) Year() int {
+	return m.year
+}
+
+// Month returns the month of the year
+func (m Month) Month() time.Month {
+	return m.month
+}
+
+// IsZero reports whether m is the zero Month
+func (m Month) IsZero() bool {
+	return m == Month{}
+}
+
+// Next returns the following month
+func (m Month) Next() Month {
+	return m.AddMonths(1)
+}
+
+// Prev returns the preceding month
+func (m Month) Prev() Month {
+	return m.AddMonths(-1)
+}
+
+// AddMonths returns the month n months after m, or before m when n is
+// negative
+func (m Month) AddMonths(n int) Month {
+	return NewMonth(m.year, m.month+time.Month(n))
+}
+
+// Before reports whether m is before n
+func (m Month) Before(n Month) bool {
+	return m.year < n.year || m.year == n.year && m.month < n.month
+}
+
+// After reports whether m is after n
+func (m Month) After(n Month) bool {
+	return n.Before(m)
+}
+
+// Date returns the first day of the month
+func (m Month) Date() Date {
+	return Date{Time: time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// String returns the month formatted as its first day, YYYY-MM-01, the
+// format the API identifies months with
+func (m Month) String() string {
+	return DateFormat(m.Date())
+}
+
+// MarshalJSON formats the month as YYYY-MM-01
+func (m Month) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%s"`, m)), nil
+}
+
+// UnmarshalJSON parses a month formatted as YYYY-MM-01
+func (m *Month) UnmarshalJSON(b []byte) error {
+	// b value comes in surrounded by quotes
+	month, err := MonthFromString(strings.Trim(string(b), "\""))
+	if err != nil {
+		return err
+	}
+
+	*m = month
+	return nil
+}
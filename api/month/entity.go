@@ -30,6 +30,9 @@ type Month struct {
 	// Activity the total amount in transactions in the month, excluding those
 	// categorized to "Inflow: To be Budgeted" (milliunits format)
 	Activity *int64 `json:"activity"`
+	// Deleted whether the month was deleted. Deleted months are only
+	// included in delta requests
+	Deleted bool `json:"deleted"`
 }
 
 // Summary represents the summary of a month for a budget
@@ -51,6 +54,9 @@ type Summary struct {
 	// Activity the total amount in transactions in the month, excluding those
 	// categorized to "Inflow: To be Budgeted" (milliunits format)
 	Activity *int64 `json:"activity"`
+	// Deleted whether the month was deleted. Deleted months are only
+	// included in delta requests
+	Deleted bool `json:"deleted"`
 }
 
 // SearchResultSnapshot represents a versioned snapshot for a month search
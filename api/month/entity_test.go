@@ -0,0 +1,29 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package month_test
+
+import (
+	"testing"
+
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/internal/apitest"
+)
+
+// TestResponses_JSON asserts the entities of the API responses served to
+// the service tests are encoded back unchanged
+func TestResponses_JSON(t *testing.T) {
+	table := []struct {
+		name, response, key string
+		v                   interface{}
+	}{
+		{"GetMonths", getMonthsResponse, "months", &[]*month.Summary{}},
+		{"GetMonth", getMonthResponse, "month", &month.Month{}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			apitest.AssertDataRoundTrip(t, test.response, test.key, test.v)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/mellis/ynab.go"
 	"github.com/mellis/ynab.go/api"
@@ -32,3 +33,12 @@ func ExampleService_GetMonths() {
 
 	// Output: *month.SearchResultSnapshot
 }
+
+//nolint:govet
+func ExampleService_GetMonthAt() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	m, _ := c.Month().GetMonthAt(context.Background(), "<valid_budget_id>", api.NewMonth(2010, time.January))
+	fmt.Println(reflect.TypeOf(m))
+
+	// Output: *month.Month
+}
@@ -48,14 +48,23 @@ func (s *Service) GetMonths(ctx context.Context, budgetID string, f *api.Filter)
 // GetMonth fetches a specific month from a budget
 // https://api.youneedabudget.com/v1#/Months/getBudgetMonth
 func (s *Service) GetMonth(ctx context.Context, budgetID string, month api.Date) (*Month, error) {
+	return s.getMonth(ctx, budgetID, api.DateFormat(month))
+}
+
+// GetMonthAt fetches a specific month from a budget
+// https://api.youneedabudget.com/v1#/Months/getBudgetMonth
+func (s *Service) GetMonthAt(ctx context.Context, budgetID string, month api.Month) (*Month, error) {
+	return s.getMonth(ctx, budgetID, month.String())
+}
+
+func (s *Service) getMonth(ctx context.Context, budgetID string, month string) (*Month, error) {
 	resModel := struct {
 		Data struct {
 			Month *Month `json:"month"`
 		} `json:"data"`
 	}{}
 
-	url := fmt.Sprintf("/budgets/%s/months/%s", budgetID,
-		api.DateFormat(month))
+	url := fmt.Sprintf("/budgets/%s/months/%s", budgetID, month)
 	if err := s.c.Get(ctx, url, &resModel); err != nil {
 		return nil, err
 	}
@@ -8,22 +8,18 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
 
 	"github.com/mellis/ynab.go"
 	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/month"
 )
 
-func TestService_GetMonths(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getMonthsResponse an API response served by TestService_GetMonths
+const getMonthsResponse = `{
   "data": {
     "months": [
       {
@@ -33,13 +29,22 @@ func TestService_GetMonths(t *testing.T) {
         "age_of_money": 14,
         "income": 3077330,
         "budgeted": 3271990,
-        "activity": -3128590
+        "activity": -3128590,
+        "deleted": false
       }
-		],
-		"server_knowledge": 10
-	}
-}
-		`)
+    ],
+    "server_knowledge": 10
+  }
+}`
+
+func TestService_GetMonths(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getMonthsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -51,6 +56,7 @@ func TestService_GetMonths(t *testing.T) {
 	assert.NoError(t, err)
 
 	m := snapshot.Months[0]
+	assert.IsType(t, &month.Summary{}, m)
 
 	var (
 		expectedAgeOfMoney      int64 = 14
@@ -70,6 +76,48 @@ func TestService_GetMonths(t *testing.T) {
 	assert.Nil(t, m.Note)
 }
 
+// getMonthResponse an API response served by TestService_GetMonth
+const getMonthResponse = `{
+  "data": {
+    "month": {
+      "month": "2017-10-01",
+      "note": null,
+      "to_be_budgeted": 0,
+      "age_of_money": 14,
+      "income": 3077330,
+      "budgeted": 3271990,
+      "activity": -3128590,
+      "categories": [
+        {
+          "id": "13419c12-78d3-4a26-82ca-1cde7aa1d6f8",
+          "category_group_id": "13419c12-78d3-4818-a5dc-601b2b8a6064",
+          "name": "MasterCard",
+          "hidden": false,
+          "budgeted": 1000,
+          "activity": 12190,
+          "balance": 18740,
+          "deleted": false,
+          "note": null,
+          "original_category_group_id": null,
+          "goal_type": "TB",
+          "goal_creation_month": "2018-04-01",
+          "goal_target": 18740,
+          "goal_target_month": null,
+          "goal_percentage_complete": 20,
+          "goal_day": null,
+          "goal_cadence": null,
+          "goal_cadence_frequency": null,
+          "goal_under_funded": null,
+          "goal_overall_funded": null,
+          "goal_overall_left": null,
+          "goal_months_to_budget": null
+        }
+      ],
+      "deleted": false
+    }
+  }
+}`
+
 func TestService_GetMonth(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -77,20 +125,7 @@ func TestService_GetMonth(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2017-10-01"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "month": {
-			"month": "2017-10-01",
-			"note": null,
-			"to_be_budgeted": 0,
-			"age_of_money": 14,
-			"income": 3077330,
-			"budgeted": 3271990,
-			"activity": -3128590
-		}
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getMonthResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -102,6 +137,7 @@ func TestService_GetMonth(t *testing.T) {
 	client := ynab.NewClient("")
 	m, err := client.Month().GetMonth(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", date)
 	assert.NoError(t, err)
+	assert.IsType(t, &month.Month{}, m)
 
 	var (
 		expectedAgeOfMoney   int64 = 14
@@ -117,4 +153,25 @@ func TestService_GetMonth(t *testing.T) {
 	assert.Equal(t, &expectedBudgeted, m.Budgeted)
 	assert.Equal(t, &expectedActivity, m.Activity)
 	assert.Nil(t, m.Note)
+	assert.Len(t, m.Categories, 1)
+}
+
+func TestService_GetMonthAt(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/months/2017-10-01"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getMonthResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	m, err := client.Month().GetMonthAt(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		api.NewMonth(2017, time.October))
+	assert.NoError(t, err)
+	assert.Equal(t, "2017-10-01 00:00:00 +0000 UTC", m.Month.String())
 }
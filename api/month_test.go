@@ -0,0 +1,87 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+func TestNewMonth(t *testing.T) {
+	m := api.NewMonth(2018, 13)
+	assert.Equal(t, 2019, m.Year())
+	assert.Equal(t, time.January, m.Month())
+
+	m = api.NewMonth(2018, 0)
+	assert.Equal(t, 2017, m.Year())
+	assert.Equal(t, time.December, m.Month())
+}
+
+func TestMonthFromString(t *testing.T) {
+	for _, s := range []string{"2018-03", "2018-03-01"} {
+		m, err := api.MonthFromString(s)
+		assert.NoError(t, err)
+		assert.Equal(t, api.NewMonth(2018, time.March), m)
+	}
+
+	for _, s := range []string{"2018-03-17", "2018-13", "2018", "current", ""} {
+		_, err := api.MonthFromString(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMonth_arithmetic(t *testing.T) {
+	m := api.NewMonth(2018, time.December)
+	assert.Equal(t, api.NewMonth(2019, time.January), m.Next())
+	assert.Equal(t, api.NewMonth(2018, time.November), m.Prev())
+	assert.Equal(t, api.NewMonth(2020, time.February), m.AddMonths(14))
+	assert.Equal(t, api.NewMonth(2017, time.December), m.AddMonths(-12))
+
+	assert.True(t, m.Before(m.Next()))
+	assert.True(t, m.After(api.NewMonth(2018, time.January)))
+	assert.False(t, m.Before(m))
+	assert.True(t, api.Month{}.IsZero())
+	assert.False(t, m.IsZero())
+
+	assert.Equal(t, "2018-12-01", m.String())
+	assert.Equal(t, "2018-12-01", api.DateFormat(m.Date()))
+}
+
+func TestMonthRange(t *testing.T) {
+	from := api.NewMonth(2018, time.November)
+	assert.Equal(t, []api.Month{
+		api.NewMonth(2018, time.November),
+		api.NewMonth(2018, time.December),
+		api.NewMonth(2019, time.January),
+	}, api.MonthRange(from, from.AddMonths(2)))
+	assert.Equal(t, []api.Month{from}, api.MonthRange(from, from))
+	assert.Empty(t, api.MonthRange(from, from.Prev()))
+}
+
+func TestCurrentMonth(t *testing.T) {
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	assert.Equal(t, api.MonthOf(time.Now().In(loc)), api.CurrentMonth(loc))
+	assert.Equal(t, api.MonthOf(time.Now().UTC()), api.CurrentMonth(nil))
+}
+
+func TestMonth_JSON(t *testing.T) {
+	wrapper := struct {
+		Month api.Month `json:"month"`
+	}{}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"month":"2018-03-01"}`), &wrapper))
+	assert.Equal(t, api.NewMonth(2018, time.March), wrapper.Month)
+
+	buf, err := json.Marshal(wrapper)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"month":"2018-03-01"}`, string(buf))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"month":"2018-03-17"}`), &wrapper))
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package payee_test
+
+import (
+	"testing"
+
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/internal/apitest"
+)
+
+// TestResponses_JSON asserts the entities of the API responses served to
+// the service tests are encoded back unchanged
+func TestResponses_JSON(t *testing.T) {
+	table := []struct {
+		name, response, key string
+		v                   interface{}
+	}{
+		{"GetPayees", getPayeesResponse, "payees", &[]*payee.Payee{}},
+		{"GetPayee", getPayeeResponse, "payee", &payee.Payee{}},
+		{"GetPayeeLocations", getPayeeLocationsResponse, "payee_locations", &[]*payee.Location{}},
+		{"GetPayeeLocation", getPayeeLocationResponse, "payee_location", &payee.Location{}},
+		{"UpdatePayee", updatePayeeResponse, "payee", &payee.Payee{}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			apitest.AssertDataRoundTrip(t, test.response, test.key, test.v)
+		})
+	}
+}
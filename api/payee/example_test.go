@@ -10,6 +10,7 @@ import (
 	"reflect"
 
 	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/payee"
 
 	"github.com/mellis/ynab.go"
 )
@@ -54,3 +55,22 @@ func ExampleService_GetPayeeLocationsByPayee() {
 
 	// Output: []*payee.Location
 }
+
+func ExampleService_UpdatePayee() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	p, _ := c.Payee().UpdatePayee(context.Background(), "<valid_budget_id>", "<valid_payee_id>",
+		payee.PayloadPayee{Name: "Supermarket"})
+	fmt.Println(reflect.TypeOf(p))
+
+	// Output: *payee.Payee
+}
+
+func ExampleService_MergePayees() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	r, err := c.Payee().MergePayees(context.Background(), "<valid_budget_id>", "<valid_payee_id>",
+		[]string{"<duplicate_payee_id>"}, true)
+	if err != nil {
+		return
+	}
+	fmt.Print(r)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package payee
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+var errInvalidMerge = errors.New("payee: invalid merge")
+
+// MergeReport represents the outcome of merging duplicate payees into a
+// canonical payee
+type MergeReport struct {
+	// PayeeID the canonical payee
+	PayeeID string
+	// DryRun whether the transactions were left unchanged
+	DryRun bool
+	// Moved the transactions re-pointed to the canonical payee, or which
+	// would be on a dry run. They hold their original payee
+	Moved []*transaction.Hybrid
+	// Skipped the subtransactions and transfers of the duplicate payees,
+	// which cannot be re-pointed through a transaction update
+	Skipped []*transaction.Hybrid
+}
+
+// String describes the report one transaction per line
+func (r *MergeReport) String() string {
+	var b strings.Builder
+	verb := "moved"
+	if r.DryRun {
+		verb = "would move"
+	}
+
+	for _, t := range r.Moved {
+		fmt.Fprintf(&b, "%s transaction %s (%s, %s) from payee %s to %s\n", verb, t.ID,
+			api.DateFormat(t.Date), api.Milliunits(t.Amount), stringOrEmpty(t.PayeeID), r.PayeeID)
+	}
+	for _, t := range r.Skipped {
+		fmt.Fprintf(&b, "skipped %s %s (%s, %s) of payee %s\n", t.Type, t.ID,
+			api.DateFormat(t.Date), api.Milliunits(t.Amount), stringOrEmpty(t.PayeeID))
+	}
+	fmt.Fprintf(&b, "%d transaction(s) %s, %d skipped\n", len(r.Moved), verb, len(r.Skipped))
+	return b.String()
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// MergePayees re-points every transaction of the duplicate payees to the
+// canonical payee, in a single transactions update. On a dry run nothing
+// is updated and the report lists the transactions which would be moved.
+// The duplicate payees themselves are left in place
+func (s *Service) MergePayees(ctx context.Context, budgetID string, payeeID string,
+	duplicateIDs []string, dryRun bool) (*MergeReport, error) {
+
+	for _, id := range duplicateIDs {
+		if id == payeeID {
+			return nil, fmt.Errorf("%w: payee %s is both canonical and duplicate", errInvalidMerge, id)
+		}
+	}
+
+	report := &MergeReport{PayeeID: payeeID, DryRun: dryRun}
+	ts := transaction.NewService(s.c)
+	for _, id := range duplicateIDs {
+		hybrids, err := ts.GetTransactionsByPayee(ctx, budgetID, id, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range hybrids {
+			switch {
+			case h.Deleted:
+			case h.Type == transaction.TypeSubTransaction || h.TransferAccountID != nil:
+				report.Skipped = append(report.Skipped, h)
+			default:
+				report.Moved = append(report.Moved, h)
+			}
+		}
+	}
+
+	if dryRun || len(report.Moved) == 0 {
+		return report, nil
+	}
+
+	// only the payee is sent, so the other fields of the transactions are
+	// left as they are now rather than as they were read
+	type payeeUpdate struct {
+		ID      string `json:"id"`
+		PayeeID string `json:"payee_id"`
+	}
+	payload := struct {
+		Transactions []payeeUpdate `json:"transactions"`
+	}{
+		Transactions: make([]payeeUpdate, 0, len(report.Moved)),
+	}
+	for _, h := range report.Moved {
+		payload.Transactions = append(payload.Transactions, payeeUpdate{ID: h.ID, PayeeID: payeeID})
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("/budgets/%s/transactions", budgetID)
+	if err := s.c.Patch(ctx, url, &struct{}{}, buf); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
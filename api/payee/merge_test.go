@@ -0,0 +1,106 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package payee_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+)
+
+const budgetURL = "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c"
+
+// registerDuplicatePayees registers the transactions of two duplicate
+// payees, pay-2 and pay-3
+func registerDuplicatePayees() {
+	bodies := map[string]string{
+		"pay-2": `{"data":{"transactions":[
+			{"id":"tx-1","type":"transaction","date":"2018-03-10","amount":-43950,"cleared":"cleared",
+			 "approved":true,"account_id":"acc-1","payee_id":"pay-2","category_id":"cat-1","memo":"weekly"},
+			{"id":"sub-1","type":"subtransaction","date":"2018-03-11","amount":-5000,"cleared":"cleared",
+			 "approved":true,"account_id":"acc-1","payee_id":"pay-2","parent_transaction_id":"tx-9"},
+			{"id":"tx-4","type":"transaction","date":"2018-03-12","amount":-1000,"account_id":"acc-1",
+			 "payee_id":"pay-2","deleted":true}
+		]}}`,
+		"pay-3": `{"data":{"transactions":[
+			{"id":"tx-2","type":"transaction","date":"2018-03-15","amount":-12000,"cleared":"uncleared",
+			 "approved":false,"account_id":"acc-2","payee_id":"pay-3","flag_color":"red"}
+		]}}`,
+	}
+	for id, body := range bodies {
+		res := httpmock.NewStringResponse(http.StatusOK, body)
+		res.Header.Add("X-Rate-Limit", "36/200")
+		httpmock.RegisterResponder(http.MethodGet, budgetURL+"/payees/"+id+"/transactions",
+			httpmock.ResponderFromResponse(res))
+	}
+}
+
+func TestService_MergePayees(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerDuplicatePayees()
+
+	httpmock.RegisterResponder(http.MethodPatch, budgetURL+"/transactions",
+		func(req *http.Request) (*http.Response, error) {
+			// nothing but the IDs and the new payee
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"transactions":[
+				{"id":"tx-1","payee_id":"pay-1"},
+				{"id":"tx-2","payee_id":"pay-1"}
+			]}`, string(body))
+
+			res := httpmock.NewStringResponse(http.StatusOK, `{"data":{"transaction_ids":["tx-1","tx-2"]}}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	r, err := client.Payee().MergePayees(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		"pay-1", []string{"pay-2", "pay-3"}, false)
+	assert.NoError(t, err)
+	assert.False(t, r.DryRun)
+	assert.Len(t, r.Moved, 2)
+	assert.Len(t, r.Skipped, 1)
+	assert.Equal(t, "sub-1", r.Skipped[0].ID)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestService_MergePayees_dryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerDuplicatePayees()
+
+	client := ynab.NewClient("")
+	r, err := client.Payee().MergePayees(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		"pay-1", []string{"pay-2", "pay-3"}, true)
+	assert.NoError(t, err)
+	assert.True(t, r.DryRun)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	assert.Equal(t, `would move transaction tx-1 (2018-03-10, -43.95) from payee pay-2 to pay-1
+would move transaction tx-2 (2018-03-15, -12) from payee pay-3 to pay-1
+skipped subtransaction sub-1 (2018-03-11, -5) of payee pay-2
+2 transaction(s) would move, 1 skipped
+`, r.String())
+}
+
+func TestService_MergePayees_invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := ynab.NewClient("")
+	_, err := client.Payee().MergePayees(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c",
+		"pay-1", []string{"pay-2", "pay-1"}, true)
+	assert.EqualError(t, err, "payee: invalid merge: payee pay-1 is both canonical and duplicate")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package payee
+
+import (
+	"errors"
+	"strings"
+)
+
+var errInvalidName = errors.New("payee: invalid payee name")
+
+// PayloadPayee is the payload contract for updating a payee
+type PayloadPayee struct {
+	Name string `json:"name"`
+}
+
+// Validate checks the payee has a name
+func (p PayloadPayee) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errInvalidName
+	}
+	return nil
+}
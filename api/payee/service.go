@@ -6,19 +6,20 @@ package payee
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/mellis/ynab.go/api"
 )
 
 // NewService facilitates the creation of a new payee service instance
-func NewService(c api.ClientReader) *Service {
+func NewService(c api.ClientReaderWriter) *Service {
 	return &Service{c}
 }
 
 // Service wraps YNAB payee API endpoints
 type Service struct {
-	c api.ClientReader
+	c api.ClientReaderWriter
 }
 
 // GetPayees fetches the list of payees from a budget
@@ -108,3 +109,36 @@ func (s *Service) GetPayeeLocationsByPayee(ctx context.Context, budgetID, payeeI
 	}
 	return resModel.Data.PayeeLocations, nil
 }
+
+// UpdatePayee updates a payee from a budget, such as to rename it
+// https://api.youneedabudget.com/v1#/Payees/updatePayee
+func (s *Service) UpdatePayee(ctx context.Context, budgetID string, payeeID string,
+	p PayloadPayee) (*Payee, error) {
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		Payee *PayloadPayee `json:"payee"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			Payee *Payee `json:"payee"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/payees/%s", budgetID, payeeID)
+	if err := s.c.Patch(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Payee, nil
+}
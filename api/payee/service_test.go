@@ -6,6 +6,7 @@ package payee_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"testing"
@@ -19,14 +20,8 @@ import (
 	"github.com/mellis/ynab.go/api/payee"
 )
 
-func TestService_GetPayees(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getPayeesResponse an API response served by TestService_GetPayees
+const getPayeesResponse = `{
   "data": {
     "payees": [
       {
@@ -38,8 +33,16 @@ func TestService_GetPayees(t *testing.T) {
 		],
 		"server_knowledge": 10
 	}
-}
-		`)
+}`
+
+func TestService_GetPayees(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getPayeesResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -64,14 +67,8 @@ func TestService_GetPayees(t *testing.T) {
 	assert.Equal(t, expected, snapshot)
 }
 
-func TestService_GetPayee(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getPayeeResponse an API response served by TestService_GetPayee
+const getPayeeResponse = `{
   "data": {
 		"payee": {
 			"id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
@@ -80,8 +77,16 @@ func TestService_GetPayee(t *testing.T) {
 			"deleted": false
 		}
 	}
-}
-		`)
+}`
+
+func TestService_GetPayee(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getPayeeResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -104,14 +109,8 @@ func TestService_GetPayee(t *testing.T) {
 	assert.Equal(t, expected, p)
 }
 
-func TestService_GetPayeeLocations(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payee_locations"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getPayeeLocationsResponse an API response served by TestService_GetPayeeLocations
+const getPayeeLocationsResponse = `{
   "data": {
     "payee_locations": [
       {
@@ -123,8 +122,16 @@ func TestService_GetPayeeLocations(t *testing.T) {
       }
 		]
 	}
-}
-		`)
+}`
+
+func TestService_GetPayeeLocations(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payee_locations"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getPayeeLocationsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -153,14 +160,8 @@ func TestService_GetPayeeLocations(t *testing.T) {
 	assert.Equal(t, expected, locations)
 }
 
-func TestService_GetPayeeLocation(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payee_locations/34fabc3-1234-4a11-8bcd-7f63756b7193"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getPayeeLocationResponse an API response served by TestService_GetPayeeLocation
+const getPayeeLocationResponse = `{
   "data": {
     "payee_location": {
 			"id": "34fabc3-1234-4a11-8bcd-7f63756b7193",
@@ -170,8 +171,16 @@ func TestService_GetPayeeLocation(t *testing.T) {
 			"deleted": false
 		}
 	}
-}
-		`)
+}`
+
+func TestService_GetPayeeLocation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payee_locations/34fabc3-1234-4a11-8bcd-7f63756b7193"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getPayeeLocationResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -209,20 +218,7 @@ func TestService_GetPayeeLocationsByPayee(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f/payee_locations"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "payee_locations": [
-      {
-        "id": "34fabc3-1234-4a11-8bcd-7f63756b7193",
-        "payee_id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
-        "latitude": "42.496348",
-        "longitude": "23.3095594",
-        "deleted": false
-      }
-		]
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getPayeeLocationsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -254,3 +250,53 @@ func TestService_GetPayeeLocationsByPayee(t *testing.T) {
 
 	assert.Equal(t, expected, locations)
 }
+
+// updatePayeeResponse an API response served by TestService_UpdatePayee
+const updatePayeeResponse = `{
+  "data": {
+    "payee": {
+      "id": "34e88373-ef48-4386-9ab3-7f86c2a8988f",
+      "name": "Supermarket",
+      "transfer_account_id": null,
+      "deleted": false
+    },
+    "server_knowledge": 12
+  }
+}`
+
+func TestService_UpdatePayee(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/34e88373-ef48-4386-9ab3-7f86c2a8988f"
+	httpmock.RegisterResponder(http.MethodPatch, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Payee *payee.PayloadPayee `json:"payee"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payee.PayloadPayee{Name: "Supermarket"}, resModel.Payee)
+
+			res := httpmock.NewStringResponse(200, updatePayeeResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	p, err := client.Payee().UpdatePayee(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"34e88373-ef48-4386-9ab3-7f86c2a8988f",
+		payee.PayloadPayee{Name: "Supermarket"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, &payee.Payee{ID: "34e88373-ef48-4386-9ab3-7f86c2a8988f", Name: "Supermarket"}, p)
+
+	_, err = client.Payee().UpdatePayee(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"34e88373-ef48-4386-9ab3-7f86c2a8988f",
+		payee.PayloadPayee{},
+	)
+	assert.EqualError(t, err, "payee: invalid payee name")
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"regexp"
 	"strconv"
+	"time"
 )
 
 var (
@@ -18,8 +19,19 @@ var (
 
 // RateLimit represents an API rate limit
 type RateLimit struct {
-	used  uint64
-	total uint64
+	used    uint64
+	total   uint64
+	resetAt time.Time
+}
+
+// NewRateLimit returns a *RateLimit for the given usage, where resetAt is
+// the moment the oldest counted request leaves the rate limit window
+func NewRateLimit(used, total uint64, resetAt time.Time) *RateLimit {
+	return &RateLimit{
+		used:    used,
+		total:   total,
+		resetAt: resetAt,
+	}
 }
 
 // Used represents the used rate limit
@@ -32,6 +44,21 @@ func (r *RateLimit) Total() uint64 {
 	return r.total
 }
 
+// Remaining represents the number of requests left in the rate limit
+func (r *RateLimit) Remaining() uint64 {
+	if r.used >= r.total {
+		return 0
+	}
+	return r.total - r.used
+}
+
+// ResetAt represents the moment the oldest counted request leaves the
+// rolling rate limit window, freeing quota for a new request. It is the
+// zero time when unknown, e.g. for a rate limit parsed from a header
+func (r *RateLimit) ResetAt() time.Time {
+	return r.resetAt
+}
+
 // ParseRateLimit returns a *RateLimit for a given rate limit string
 func ParseRateLimit(rateLimit string) (*RateLimit, error) {
 	m := rateLimitRegex.FindStringSubmatch(rateLimit)
@@ -35,3 +35,18 @@ func TestParseRateLimit(t *testing.T) {
 		assert.Equal(t, test.Out, rl)
 	}
 }
+
+func TestRateLimit_Remaining(t *testing.T) {
+	table := []struct {
+		In  *RateLimit
+		Out uint64
+	}{
+		{&RateLimit{used: 1, total: 200}, 199},
+		{&RateLimit{used: 200, total: 200}, 0},
+		{&RateLimit{used: 201, total: 200}, 0},
+	}
+
+	for _, test := range table {
+		assert.Equal(t, test.Out, test.In.Remaining())
+	}
+}
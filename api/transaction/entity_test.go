@@ -0,0 +1,53 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package transaction_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/transaction"
+	"github.com/mellis/ynab.go/internal/apitest"
+)
+
+// TestResponses_JSON asserts the entities of the API responses served to
+// the service tests are encoded back unchanged
+func TestResponses_JSON(t *testing.T) {
+	table := []struct {
+		name, response, key string
+		v                   interface{}
+	}{
+		{"GetTransactions", getTransactionsResponse, "transactions", &[]*transaction.Transaction{}},
+		{"GetTransaction", getTransactionResponse, "transaction", &transaction.Transaction{}},
+		{"GetTransactionsByCategory", getTransactionsByCategoryResponse, "transactions", &[]*transaction.Hybrid{}},
+		{"GetScheduledTransactions", getScheduledTransactionsResponse, "scheduled_transactions", &[]*transaction.Scheduled{}},
+		{"GetScheduledTransaction", getScheduledTransactionResponse, "scheduled_transaction", &transaction.Scheduled{}},
+		{"CreateTransaction", createTransactionResponse, "transaction", &transaction.Transaction{}},
+		{"CreateTransactions", createTransactionsResponse, "transactions", &[]*transaction.Transaction{}},
+		{"BulkCreateTransactions", bulkCreateTransactionsResponse, "bulk", &transaction.Bulk{}},
+		{"UpdateTransaction", updateTransactionResponse, "transaction", &transaction.Transaction{}},
+		{"DeleteTransaction", deleteTransactionResponse, "transaction", &transaction.Transaction{}},
+		{"DeleteTransactions cancelled", deleteTransactionsCancelledResponse, "transaction", &transaction.Transaction{}},
+		{"DeleteScheduledTransaction", deleteScheduledTransactionResponse, "scheduled_transaction", &transaction.Scheduled{}},
+	}
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			apitest.AssertDataRoundTrip(t, test.response, test.key, test.v)
+		})
+	}
+}
+
+func TestPayloadTransaction_JSON(t *testing.T) {
+	date, err := api.DateFromString("2018-03-10")
+	assert.NoError(t, err)
+
+	// payloads are marshalled by value, not only through a pointer
+	buf, err := json.Marshal(transaction.PayloadTransaction{Date: date})
+	assert.NoError(t, err)
+	assert.Contains(t, string(buf), `"date":"2018-03-10"`)
+}
@@ -90,6 +90,18 @@ const (
 	FrequencyEveryOtherYear ScheduledFrequency = "everyOtherYear"
 )
 
+// Valid reports whether the frequency is one of the known frequencies
+func (f ScheduledFrequency) Valid() bool {
+	switch f {
+	case FrequencyNever, FrequencyDaily, FrequencyWeekly, FrequencyEveryOtherWeek,
+		FrequencyTwiceAMonth, FrequencyEveryFourWeeks, FrequencyMonthly,
+		FrequencyEveryOtherMonth, FrequencyEveryThreeMonths, FrequencyEveryFourMonths,
+		FrequencyTwiceAYear, FrequencyYearly, FrequencyEveryOtherYear:
+		return true
+	}
+	return false
+}
+
 // Type represents the type of a hybrid transaction
 type Type string
 
@@ -0,0 +1,48 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package transaction
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BatchError represents the partial failure of a batch operation
+type BatchError struct {
+	// Failures the failed operations, in the order they were attempted
+	Failures []*Failure
+}
+
+// Failure represents a failed operation on a single transaction
+type Failure struct {
+	TransactionID string
+	Err           error
+}
+
+// Error returns the string version of the error
+func (e *BatchError) Error() string {
+	msgs := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", f.TransactionID, f.Err))
+	}
+	return fmt.Sprintf("transaction: %d operation(s) failed: %s",
+		len(e.Failures), strings.Join(msgs, "; "))
+}
+
+// FailedIDs returns the IDs of the transactions whose operation failed
+func (e *BatchError) FailedIDs() []string {
+	ids := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		ids = append(ids, f.TransactionID)
+	}
+	return ids
+}
+
+func (e *BatchError) add(transactionID string, err error) {
+	e.Failures = append(e.Failures, &Failure{
+		TransactionID: transactionID,
+		Err:           err,
+	})
+}
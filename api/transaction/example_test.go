@@ -71,6 +71,24 @@ func ExampleService_UpdateTransaction() {
 	// Output: *transaction.Transaction
 }
 
+func ExampleService_DeleteTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	tx, _ := c.Transaction().DeleteTransaction(context.Background(), "<valid_budget_id>",
+		"<valid_transaction_id>")
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Transaction
+}
+
+func ExampleService_DeleteTransactions() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	txs, _ := c.Transaction().DeleteTransactions(context.Background(), "<valid_budget_id>",
+		[]string{"<valid_transaction_id>", "<another_valid_transaction_id>"})
+	fmt.Println(reflect.TypeOf(txs))
+
+	// Output: []*transaction.Transaction
+}
+
 func ExampleService_GetTransaction() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
 	tx, _ := c.Transaction().GetTransaction(context.Background(), "<valid_budget_id>",
@@ -82,7 +100,7 @@ func ExampleService_GetTransaction() {
 
 func ExampleService_GetTransactions() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
-	transactions, _ := c.Transaction().GetTransactions(context.Background(), "<valid_budget_id>", nil)
+	transactions, _, _ := c.Transaction().GetTransactions(context.Background(), "<valid_budget_id>", nil)
 	fmt.Println(reflect.TypeOf(transactions))
 
 	// Output: []*transaction.Transaction
@@ -95,7 +113,7 @@ func ExampleService_GetTransactions_filtered() {
 		Since: &date,
 		Type:  transaction.StatusUnapproved.Pointer(),
 	}
-	transactions, _ := c.Transaction().GetTransactions(context.Background(), "<valid_budget_id>", f)
+	transactions, _, _ := c.Transaction().GetTransactions(context.Background(), "<valid_budget_id>", f)
 	fmt.Println(reflect.TypeOf(transactions))
 
 	// Output: []*transaction.Transaction
@@ -189,3 +207,43 @@ func ExampleService_GetScheduledTransactions() {
 
 	// Output: []*transaction.Scheduled
 }
+
+func ExampleService_CreateScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	date, _ := api.DateFromString("2030-01-01")
+	p := transaction.PayloadScheduledTransaction{
+		AccountID: "<valid_account_id>",
+		DateFirst: date,
+		Frequency: transaction.FrequencyMonthly,
+		// ...
+	}
+	tx, _ := c.Transaction().CreateScheduledTransaction(context.Background(), "<valid_budget_id>", p)
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
+
+func ExampleService_UpdateScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	date, _ := api.DateFromString("2030-01-01")
+	p := transaction.PayloadScheduledTransaction{
+		AccountID: "<valid_account_id>",
+		DateFirst: date,
+		Frequency: transaction.FrequencyYearly,
+		// ...
+	}
+	tx, _ := c.Transaction().UpdateScheduledTransaction(context.Background(), "<valid_budget_id>",
+		"<valid_scheduled_transaction_id>", p)
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
+
+func ExampleService_DeleteScheduledTransaction() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	tx, _ := c.Transaction().DeleteScheduledTransaction(context.Background(), "<valid_budget_id>",
+		"<valid_scheduled_transaction_id>")
+	fmt.Println(reflect.TypeOf(tx))
+
+	// Output: *transaction.Scheduled
+}
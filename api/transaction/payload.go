@@ -5,9 +5,26 @@
 package transaction
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/mellis/ynab.go/api"
 )
 
+// scheduledDateFirstMaxYears the number of years in the future the first
+// date of a scheduled transaction can be set to
+const scheduledDateFirstMaxYears = 5
+
+var (
+	errInvalidFrequency = errors.New("transaction: invalid scheduled transaction frequency")
+	errInvalidDateFirst = errors.New("transaction: invalid scheduled transaction first date")
+
+	errInvalidSubTransactions = errors.New("transaction: invalid subtransactions")
+)
+
 // PayloadTransaction is the payload contract for saving a transaction, new or existent
 type PayloadTransaction struct {
 	ID        string   `json:"id"`
@@ -39,4 +56,97 @@ type PayloadTransaction struct {
 	// was imported and had the same date and same amount, its import_id would
 	// be 'YNAB:-294230:2015-12-30:2’.
 	ImportID *string `json:"import_id"`
+	// SubTransactions The sub-transactions of a split transaction. Their
+	// amounts must sum to the transaction amount
+	SubTransactions []PayloadSubTransaction `json:"subtransactions,omitempty"`
+}
+
+// PayloadSubTransaction is the payload contract for a sub-transaction of
+// a split transaction
+type PayloadSubTransaction struct {
+	// Amount The sub-transaction amount in milliunits format
+	Amount int64 `json:"amount"`
+
+	// PayeeID Transfer payees are not permitted and will be ignored if supplied
+	PayeeID *string `json:"payee_id"`
+	// PayeeName If the payee name is provided and payee ID has a null value, the
+	// payee name value will be used to resolve the payee by either (1) a payee
+	// with the same name or (2) creation of a new payee
+	PayeeName *string `json:"payee_name"`
+	// CategoryID Credit Card Payment categories are not permitted and will be
+	// ignored if supplied
+	CategoryID *string `json:"category_id"`
+	Memo       *string `json:"memo"`
+}
+
+// Validate checks the payload before it is sent to the API
+func (p PayloadTransaction) Validate() error {
+	if len(p.SubTransactions) == 0 {
+		return nil
+	}
+
+	var sum int64
+	amounts := make([]string, 0, len(p.SubTransactions))
+	for _, st := range p.SubTransactions {
+		sum += st.Amount
+		amounts = append(amounts, strconv.FormatInt(st.Amount, 10))
+	}
+	if sum != p.Amount {
+		return fmt.Errorf("%w: amounts [%s] sum to %d milliunits but the transaction amount is %d milliunits, off by %d",
+			errInvalidSubTransactions, strings.Join(amounts, " "), sum, p.Amount, sum-p.Amount)
+	}
+	return nil
+}
+
+// validatePayloads validates each payload of a batch
+func validatePayloads(ps []PayloadTransaction) error {
+	for i, p := range ps {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("transaction at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// PayloadScheduledTransaction is the payload contract for saving a scheduled
+// transaction, new or existent
+type PayloadScheduledTransaction struct {
+	AccountID string `json:"account_id"`
+	// DateFirst The first date the scheduled transaction occurs on. It must
+	// not be more than five years in the future
+	DateFirst api.Date           `json:"date"`
+	Frequency ScheduledFrequency `json:"frequency"`
+	// Amount The scheduled transaction amount in milliunits format
+	Amount int64 `json:"amount"`
+
+	// PayeeID Transfer payees are not permitted and will be ignored if supplied
+	PayeeID *string `json:"payee_id"`
+	// PayeeName If the payee name is provided and payee ID has a null value, the
+	// payee name value will be used to resolve the payee by either (1) a payee
+	// with the same name or (2) creation of a new payee
+	PayeeName *string `json:"payee_name"`
+	// CategoryID Credit Card Payment categories are not permitted and will be
+	// ignored if supplied
+	CategoryID *string    `json:"category_id"`
+	Memo       *string    `json:"memo"`
+	FlagColor  *FlagColor `json:"flag_color"`
+}
+
+// Validate checks the payload before it is sent to the API
+func (p PayloadScheduledTransaction) Validate() error {
+	return p.validate(time.Now())
+}
+
+func (p PayloadScheduledTransaction) validate(now time.Time) error {
+	if !p.Frequency.Valid() {
+		return fmt.Errorf("%w: %q", errInvalidFrequency, p.Frequency)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	maxDateFirst := today.AddDate(scheduledDateFirstMaxYears, 0, 0)
+	if p.DateFirst.After(maxDateFirst) {
+		return fmt.Errorf("%w: %s is more than %d years in the future",
+			errInvalidDateFirst, api.DateFormat(p.DateFirst), scheduledDateFirstMaxYears)
+	}
+	return nil
 }
@@ -0,0 +1,108 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package transaction_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+func TestPayloadScheduledTransaction_Validate(t *testing.T) {
+	dateIn := func(years, months int) api.Date {
+		date, err := api.DateFromString(time.Now().AddDate(years, months, 0).Format("2006-01-02"))
+		assert.NoError(t, err)
+		return date
+	}
+
+	table := []struct {
+		Name    string
+		Payload transaction.PayloadScheduledTransaction
+		Err     string
+	}{
+		{
+			Name:    "valid",
+			Payload: transaction.PayloadScheduledTransaction{DateFirst: dateIn(0, 1), Frequency: transaction.FrequencyEveryOtherWeek},
+		},
+		{
+			Name:    "first date five years ahead",
+			Payload: transaction.PayloadScheduledTransaction{DateFirst: dateIn(5, 0), Frequency: transaction.FrequencyNever},
+		},
+		{
+			Name:    "first date more than five years ahead",
+			Payload: transaction.PayloadScheduledTransaction{DateFirst: dateIn(5, 1), Frequency: transaction.FrequencyYearly},
+			Err: "transaction: invalid scheduled transaction first date: " +
+				api.DateFormat(dateIn(5, 1)) + " is more than 5 years in the future",
+		},
+		{
+			Name:    "unknown frequency",
+			Payload: transaction.PayloadScheduledTransaction{DateFirst: dateIn(0, 1), Frequency: "every5Weeks"},
+			Err:     `transaction: invalid scheduled transaction frequency: "every5Weeks"`,
+		},
+		{
+			Name:    "missing frequency",
+			Payload: transaction.PayloadScheduledTransaction{DateFirst: dateIn(0, 1)},
+			Err:     `transaction: invalid scheduled transaction frequency: ""`,
+		},
+	}
+
+	for _, test := range table {
+		err := test.Payload.Validate()
+		if test.Err == "" {
+			assert.NoError(t, err, test.Name)
+		} else {
+			assert.EqualError(t, err, test.Err, test.Name)
+		}
+	}
+}
+
+func TestPayloadTransaction_Validate(t *testing.T) {
+	table := []struct {
+		Name    string
+		Payload transaction.PayloadTransaction
+		Err     string
+	}{
+		{
+			Name:    "without subtransactions",
+			Payload: transaction.PayloadTransaction{Amount: -10000},
+		},
+		{
+			Name: "subtransactions summing to the amount",
+			Payload: transaction.PayloadTransaction{
+				Amount: -10000,
+				SubTransactions: []transaction.PayloadSubTransaction{
+					{Amount: -7500},
+					{Amount: -3000},
+					{Amount: 500},
+				},
+			},
+		},
+		{
+			Name: "subtransactions not summing to the amount",
+			Payload: transaction.PayloadTransaction{
+				Amount: -10000,
+				SubTransactions: []transaction.PayloadSubTransaction{
+					{Amount: -7500},
+					{Amount: -3000},
+				},
+			},
+			Err: "transaction: invalid subtransactions: amounts [-7500 -3000] sum to -10500 milliunits " +
+				"but the transaction amount is -10000 milliunits, off by -500",
+		},
+	}
+
+	for _, test := range table {
+		err := test.Payload.Validate()
+		if test.Err == "" {
+			assert.NoError(t, err, test.Name)
+		} else {
+			assert.EqualError(t, err, test.Err, test.Name)
+		}
+	}
+}
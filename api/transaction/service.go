@@ -75,6 +75,10 @@ func (s *Service) CreateTransaction(ctx context.Context, budgetID string,
 func (s *Service) CreateTransactions(ctx context.Context, budgetID string,
 	p []PayloadTransaction) (*OperationSummary, error) {
 
+	if err := validatePayloads(p); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -104,6 +108,10 @@ func (s *Service) CreateTransactions(ctx context.Context, budgetID string,
 func (s *Service) BulkCreateTransactions(ctx context.Context, budgetID string,
 	ps []PayloadTransaction) (*Bulk, error) {
 
+	if err := validatePayloads(ps); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -133,6 +141,10 @@ func (s *Service) BulkCreateTransactions(ctx context.Context, budgetID string,
 func (s *Service) UpdateTransaction(ctx context.Context, budgetID string, transactionID string,
 	p PayloadTransaction) (*Transaction, error) {
 
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transaction *PayloadTransaction `json:"transaction"`
 	}{
@@ -162,6 +174,10 @@ func (s *Service) UpdateTransaction(ctx context.Context, budgetID string, transa
 func (s *Service) UpdateTransactions(ctx context.Context, budgetID string,
 	p []PayloadTransaction) (*OperationSummary, error) {
 
+	if err := validatePayloads(p); err != nil {
+		return nil, err
+	}
+
 	payload := struct {
 		Transactions []PayloadTransaction `json:"transactions"`
 	}{
@@ -185,6 +201,54 @@ func (s *Service) UpdateTransactions(ctx context.Context, budgetID string,
 	return resModel.Data, nil
 }
 
+// DeleteTransaction deletes a transaction from a budget and returns
+// the deleted transaction
+// https://api.youneedabudget.com/v1#/Transactions/deleteTransaction
+func (s *Service) DeleteTransaction(ctx context.Context, budgetID string, transactionID string) (*Transaction, error) {
+	resModel := struct {
+		Data struct {
+			Transaction *Transaction `json:"transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/transactions/%s", budgetID, transactionID)
+	if err := s.c.Delete(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.Transaction, nil
+}
+
+// DeleteTransactions deletes multiple transactions from a budget, one
+// request per transaction, and returns the deleted transactions.
+// A failure does not stop the batch: the transactions which could not be
+// deleted are reported by a *BatchError. Once ctx is done, no more
+// requests are sent and the remaining transactions are reported as failed
+// with the context error
+func (s *Service) DeleteTransactions(ctx context.Context, budgetID string,
+	transactionIDs []string) ([]*Transaction, error) {
+
+	deleted := make([]*Transaction, 0, len(transactionIDs))
+	batchErr := &BatchError{}
+	for _, id := range transactionIDs {
+		if err := ctx.Err(); err != nil {
+			batchErr.add(id, err)
+			continue
+		}
+
+		tx, err := s.DeleteTransaction(ctx, budgetID, id)
+		if err != nil {
+			batchErr.add(id, err)
+			continue
+		}
+		deleted = append(deleted, tx)
+	}
+
+	if len(batchErr.Failures) > 0 {
+		return deleted, batchErr
+	}
+	return deleted, nil
+}
+
 // GetTransactionsByAccount fetches the list of transactions of a specific account
 // from a budget with filtering capabilities
 // https://api.youneedabudget.com/v1#/Transactions/getTransactionsByAccount
@@ -291,6 +355,91 @@ func (s *Service) GetScheduledTransaction(ctx context.Context, budgetID, schedul
 	return resModel.Data.ScheduledTransactions, nil
 }
 
+// CreateScheduledTransaction creates a new scheduled transaction for a budget
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/createScheduledTransaction
+func (s *Service) CreateScheduledTransaction(ctx context.Context, budgetID string,
+	p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		ScheduledTransaction *PayloadScheduledTransaction `json:"scheduled_transaction"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions", budgetID)
+	if err := s.c.Post(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
+
+// UpdateScheduledTransaction updates a whole scheduled transaction for a replacement
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/updateScheduledTransaction
+func (s *Service) UpdateScheduledTransaction(ctx context.Context, budgetID, scheduledTransactionID string,
+	p PayloadScheduledTransaction) (*Scheduled, error) {
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		ScheduledTransaction *PayloadScheduledTransaction `json:"scheduled_transaction"`
+	}{
+		&p,
+	}
+
+	buf, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions/%s", budgetID, scheduledTransactionID)
+	if err := s.c.Put(ctx, url, &resModel, buf); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
+
+// DeleteScheduledTransaction deletes a scheduled transaction from a budget
+// and returns the deleted scheduled transaction
+// https://api.youneedabudget.com/v1#/Scheduled_Transactions/deleteScheduledTransaction
+func (s *Service) DeleteScheduledTransaction(ctx context.Context, budgetID,
+	scheduledTransactionID string) (*Scheduled, error) {
+
+	resModel := struct {
+		Data struct {
+			ScheduledTransaction *Scheduled `json:"scheduled_transaction"`
+		} `json:"data"`
+	}{}
+
+	url := fmt.Sprintf("/budgets/%s/scheduled_transactions/%s", budgetID, scheduledTransactionID)
+	if err := s.c.Delete(ctx, url, &resModel); err != nil {
+		return nil, err
+	}
+	return resModel.Data.ScheduledTransaction, nil
+}
+
 // Filter represents the optional filter while fetching transactions
 type Filter struct {
 	Since *api.Date
@@ -7,8 +7,10 @@ package transaction_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
@@ -18,14 +20,8 @@ import (
 	"github.com/mellis/ynab.go/api/transaction"
 )
 
-func TestService_GetTransactions(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getTransactionsResponse an API response served by TestService_GetTransactions
+const getTransactionsResponse = `{
   "data": {
     "transactions": [
       {
@@ -54,21 +50,37 @@ func TestService_GetTransactions(t *testing.T) {
             "payee_id": "6216ab4b-bb05-4574-b4b5-be2dee26ab0d",
             "category_id": "080985e4-4175-43e4-96bb-d207a9d2c8ce",
             "transfer_account_id": null,
-            "deleted": false
+            "deleted": false,
+            "payee_name": null,
+            "category_name": null,
+            "transfer_transaction_id": null
           }
-				]
-			}
-		]
-	}
-}
-		`)
+        ],
+        "transfer_transaction_id": null,
+        "matched_transaction_id": null,
+        "import_payee_name": null,
+        "import_payee_name_original": null,
+        "debt_transaction_type": null
+      }
+    ]
+  }
+}`
+
+func TestService_GetTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getTransactionsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
 	)
 
 	client := ynab.NewClient("")
-	transactions, err := client.Transaction().GetTransactions(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
+	transactions, _, err := client.Transaction().GetTransactions(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c", nil)
 	assert.NoError(t, err)
 
 	expectedDate, err := api.DateFromString("2018-03-10")
@@ -115,6 +127,50 @@ func TestService_GetTransactions(t *testing.T) {
 	assert.Equal(t, expected, transactions)
 }
 
+// getTransactionResponse an API response served by TestService_GetTransaction
+const getTransactionResponse = `{
+  "data": {
+    "transaction": {
+      "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+      "date": "2018-03-10",
+      "amount": -43950,
+      "memo": "nice memo",
+      "cleared": "reconciled",
+      "approved": true,
+      "flag_color": null,
+      "account_id": "09eaca5e-6f16-4480-9515-828fb90638f2",
+      "account_name": "Bank Name",
+      "payee_id": "6216ab4b-6f16-4480-9515-be2dee26ab0d",
+      "payee_name": "Supermarket",
+      "category_id": "e9517027-6f16-4480-9515-5981bed2e9e1",
+      "category_name": "Split (Multiple Categories)...",
+      "transfer_account_id": null,
+      "import_id": null,
+      "deleted": false,
+      "subtransactions": [
+        {
+          "id": "9453526b-2f58-4c02-9683-a30c2a1192d7",
+          "transaction_id": "e6ad88f5-6f16-4480-9515-5377012750dd",
+          "amount": -33970,
+          "memo": "Debit Card Payment",
+          "payee_id": "6216ab4b-bb05-4574-b4b5-be2dee26ab0d",
+          "category_id": "080985e4-4175-43e4-96bb-d207a9d2c8ce",
+          "transfer_account_id": null,
+          "deleted": false,
+          "payee_name": null,
+          "category_name": null,
+          "transfer_transaction_id": null
+        }
+      ],
+      "transfer_transaction_id": null,
+      "matched_transaction_id": null,
+      "import_payee_name": null,
+      "import_payee_name_original": null,
+      "debt_transaction_type": null
+    }
+  }
+}`
+
 func TestService_GetTransaction(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -122,41 +178,7 @@ func TestService_GetTransaction(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/e6ad88f5-6f16-4480-9515-5377012750dd"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "transaction": {
-			"id": "e6ad88f5-6f16-4480-9515-5377012750dd",
-			"date": "2018-03-10",
-			"amount": -43950,
-			"memo": "nice memo",
-			"cleared": "reconciled",
-			"approved": true,
-			"flag_color": null,
-			"account_id": "09eaca5e-6f16-4480-9515-828fb90638f2",
-			"account_name": "Bank Name",
-			"payee_id": "6216ab4b-6f16-4480-9515-be2dee26ab0d",
-			"payee_name": "Supermarket",
-			"category_id": "e9517027-6f16-4480-9515-5981bed2e9e1",
-			"category_name": "Split (Multiple Categories)...",
-			"transfer_account_id": null,
-			"import_id": null,
-			"deleted": false,
-			"subtransactions": [
-			  {
-			    "id": "9453526b-2f58-4c02-9683-a30c2a1192d7",
-			    "transaction_id": "e6ad88f5-6f16-4480-9515-5377012750dd",
-			    "amount": -33970,
-			    "memo": "Debit Card Payment",
-			    "payee_id": "6216ab4b-bb05-4574-b4b5-be2dee26ab0d",
-			    "category_id": "080985e4-4175-43e4-96bb-d207a9d2c8ce",
-			    "transfer_account_id": null,
-			    "deleted": false
-			  }
-			]
-		}
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getTransactionResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -218,43 +240,7 @@ func TestService_GetTransactionsByAccount(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/accounts/09eaca5e-6f16-4480-9515-828fb90638f2/transactions"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "transactions": [
-      {
-        "id": "e6ad88f5-6f16-4480-9515-5377012750dd",
-        "date": "2018-03-10",
-        "amount": -43950,
-        "memo": "nice memo",
-        "cleared": "reconciled",
-        "approved": true,
-        "flag_color": null,
-        "account_id": "09eaca5e-6f16-4480-9515-828fb90638f2",
-        "account_name": "Bank Name",
-        "payee_id": "6216ab4b-6f16-4480-9515-be2dee26ab0d",
-        "payee_name": "Supermarket",
-        "category_id": "e9517027-6f16-4480-9515-5981bed2e9e1",
-        "category_name": "Split (Multiple Categories)...",
-        "transfer_account_id": null,
-        "import_id": null,
-        "deleted": false,
-        "subtransactions": [
-          {
-            "id": "9453526b-2f58-4c02-9683-a30c2a1192d7",
-            "transaction_id": "e6ad88f5-6f16-4480-9515-5377012750dd",
-            "amount": -33970,
-            "memo": "Debit Card Payment",
-            "payee_id": "6216ab4b-bb05-4574-b4b5-be2dee26ab0d",
-            "category_id": "080985e4-4175-43e4-96bb-d207a9d2c8ce",
-            "transfer_account_id": null,
-            "deleted": false
-          }
-				]
-			}
-		]
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getTransactionsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -312,14 +298,8 @@ func TestService_GetTransactionsByAccount(t *testing.T) {
 	assert.Equal(t, expected, transactions)
 }
 
-func TestService_GetTransactionsByCategory(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/a33c906e-444c-469c-be27-04c8e0c9959f/transactions"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getTransactionsByCategoryResponse an API response served by TestService_GetTransactionsByCategory
+const getTransactionsByCategoryResponse = `{
   "data": {
     "transactions": [
       {
@@ -344,8 +324,16 @@ func TestService_GetTransactionsByCategory(t *testing.T) {
       }
 		]
 	}
-}
-		`)
+}`
+
+func TestService_GetTransactionsByCategory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/categories/a33c906e-444c-469c-be27-04c8e0c9959f/transactions"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getTransactionsByCategoryResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -397,33 +385,7 @@ func TestService_GetTransactionsByPayee(t *testing.T) {
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/payees/b391144e-444c-469c-be27-fed6aa352a7a/transactions"
 	httpmock.RegisterResponder(http.MethodGet, url,
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "transactions": [
-      {
-        "type": "transaction",
-        "id": "c132c55c-1200-4606-a321-99f4ec24b4df",
-        "parent_transaction_id": null,
-        "date": "2018-01-10",
-        "amount": -42000,
-        "memo": "",
-        "cleared": "reconciled",
-        "approved": true,
-        "flag_color": null,
-        "account_id": "134d159-444c-469c-be27-44094e388fa0",
-        "account_name": "Cash",
-        "payee_id": "b391144e-444c-469c-be27-fed6aa352a7a",
-        "payee_name": "Landlord",
-        "category_id": "a33c906e-444c-469c-be27-04c8e0c9959f",
-        "category_name": "Rent",
-        "transfer_account_id": null,
-        "import_id": null,
-        "deleted": false
-      }
-		]
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, getTransactionsByCategoryResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -468,14 +430,8 @@ func TestService_GetTransactionsByPayee(t *testing.T) {
 	assert.Equal(t, expected, transactions)
 }
 
-func TestService_GetScheduledTransactions(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getScheduledTransactionsResponse an API response served by TestService_GetScheduledTransactions
+const getScheduledTransactionsResponse = `{
   "data": {
     "scheduled_transactions": [
       {
@@ -498,8 +454,16 @@ func TestService_GetScheduledTransactions(t *testing.T) {
       }
 		]
 	}
-}
-		`)
+}`
+
+func TestService_GetScheduledTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getScheduledTransactionsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -543,14 +507,8 @@ func TestService_GetScheduledTransactions(t *testing.T) {
 	assert.Equal(t, expected, transactions)
 }
 
-func TestService_GetScheduledTransaction(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
-	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
-	httpmock.RegisterResponder(http.MethodGet, url,
-		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
+// getScheduledTransactionResponse an API response served by TestService_GetScheduledTransaction
+const getScheduledTransactionResponse = `{
   "data": {
     "scheduled_transaction": {
 			"id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
@@ -571,8 +529,16 @@ func TestService_GetScheduledTransaction(t *testing.T) {
 			"subtransactions": []
     }
 	}
-}
-		`)
+}`
+
+func TestService_GetScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodGet, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, getScheduledTransactionResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -616,6 +582,40 @@ func TestService_GetScheduledTransaction(t *testing.T) {
 	assert.Equal(t, expected, stx)
 }
 
+// createTransactionResponse an API response served by TestService_CreateTransaction
+const createTransactionResponse = `{
+  "data": {
+    "transaction_ids": [
+      "0f5b3f73-ded2-4dd7-8b01-c23022622cd6"
+    ],
+    "duplicate_import_ids": [],
+    "transaction": {
+      "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "date": "2018-11-13",
+      "amount": -9000,
+      "memo": "nice memo",
+      "cleared": "cleared",
+      "approved": true,
+      "flag_color": "blue",
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+      "payee_name": "bla bla bla",
+      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+      "category_name": "Groceries",
+      "transfer_account_id": null,
+      "import_id": null,
+      "deleted": false,
+      "subtransactions": [],
+      "transfer_transaction_id": null,
+      "matched_transaction_id": null,
+      "import_payee_name": null,
+      "import_payee_name_original": null,
+      "debt_transaction_type": null
+    }
+  }
+}`
+
 func TestService_CreateTransaction(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -651,32 +651,7 @@ func TestService_CreateTransaction(t *testing.T) {
 			err := json.NewDecoder(req.Body).Decode(&resModel)
 			assert.NoError(t, err)
 
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"],
-		"duplicate_import_ids": [],
-    "transaction": {
-			"id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-      "date": "2018-11-13",
-      "amount": -9000,
-      "memo": "nice memo",
-      "cleared": "cleared",
-      "approved": true,
-      "flag_color": "blue",
-      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-      "account_name": "Bank Name",
-      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-      "payee_name": "bla bla bla",
-      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-      "category_name": "Groceries",
-      "transfer_account_id": null,
-      "import_id": null,
-      "deleted": false,
-      "subtransactions": []
-		}
-  }
-}
-		`)
+			res := httpmock.NewStringResponse(200, createTransactionResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -714,59 +689,74 @@ func TestService_CreateTransaction(t *testing.T) {
 	assert.Equal(t, expectedTransactions, tx)
 }
 
-func TestService_CreateTransactions(t *testing.T) {
+// createSplitTransactionResponse an API response served by TestService_CreateTransaction_split
+const createSplitTransactionResponse = `{
+  "data": {
+    "transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6"],
+    "duplicate_import_ids": []
+  }
+}`
+
+func TestService_CreateTransaction_split(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
 	payloadDate, err := api.DateFromString("2018-11-13")
 	assert.NoError(t, err)
 
-	payloadPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
-	payloadPayeeName := "bla bla bla"
-	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
-	payloadMemo := "nice memo"
-	payloadFlagColor := transaction.FlagColorBlue
+	groceriesID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	householdID := "080985e4-4175-43e4-96bb-d207a9d2c8ce"
+	householdMemo := "batteries"
 
-	payload := []transaction.PayloadTransaction{
-		{
-			AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-			Date:       payloadDate,
-			Amount:     int64(-9000),
-			Cleared:    transaction.ClearingStatusCleared,
-			Approved:   true,
-			PayeeID:    &payloadPayeeID,
-			PayeeName:  &payloadPayeeName,
-			CategoryID: &payloadCategoryID,
-			Memo:       &payloadMemo,
-			FlagColor:  &payloadFlagColor,
-		},
-		{
-			AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-			Date:       payloadDate,
-			Amount:     int64(-2000),
-			Cleared:    transaction.ClearingStatusUncleared,
-			Approved:   false,
-			PayeeID:    &payloadPayeeID,
-			PayeeName:  &payloadPayeeName,
-			CategoryID: &payloadCategoryID,
-			Memo:       &payloadMemo,
-			FlagColor:  &payloadFlagColor,
+	payload := transaction.PayloadTransaction{
+		AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		Date:      payloadDate,
+		Amount:    int64(-43950),
+		Cleared:   transaction.ClearingStatusCleared,
+		SubTransactions: []transaction.PayloadSubTransaction{
+			{Amount: int64(-33970), CategoryID: &groceriesID},
+			{Amount: int64(-9980), CategoryID: &householdID, Memo: &householdMemo},
 		},
 	}
 
 	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
 	httpmock.RegisterResponder(http.MethodPost, url,
 		func(req *http.Request) (*http.Response, error) {
-			resModel := struct {
-				Data *transaction.OperationSummary `json:"data"`
+			reqModel := struct {
+				Transactions []transaction.PayloadTransaction `json:"transactions"`
 			}{}
-			err := json.NewDecoder(req.Body).Decode(&resModel)
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
 			assert.NoError(t, err)
+			assert.Equal(t, []transaction.PayloadTransaction{payload}, reqModel.Transactions)
 
-			res := httpmock.NewStringResponse(200, `{
+			res := httpmock.NewStringResponse(201, createSplitTransactionResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	summary, err := client.Transaction().CreateTransaction(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0f5b3f73-ded2-4dd7-8b01-c23022622cd6"}, summary.TransactionIDs)
+
+	payload.SubTransactions[1].Amount = int64(-9000)
+	_, err = client.Transaction().CreateTransactions(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", []transaction.PayloadTransaction{{}, payload})
+	assert.EqualError(t, err, "transaction at index 1: transaction: invalid subtransactions: "+
+		"amounts [-33970 -9000] sum to -42970 milliunits but the transaction amount is -43950 milliunits, off by 980")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+// createTransactionsResponse an API response served by TestService_CreateTransactions
+const createTransactionsResponse = `{
   "data": {
-		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6", "0f5b3f73-ded2-4dd7-8b01-c23022622cd7"],
-		"duplicate_import_ids": [],
+    "transaction_ids": [
+      "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "0f5b3f73-ded2-4dd7-8b01-c23022622cd7"
+    ],
+    "duplicate_import_ids": [],
     "transactions": [
       {
         "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
@@ -785,7 +775,12 @@ func TestService_CreateTransactions(t *testing.T) {
         "transfer_account_id": null,
         "import_id": null,
         "deleted": false,
-        "subtransactions": []
+        "subtransactions": [],
+        "transfer_transaction_id": null,
+        "matched_transaction_id": null,
+        "import_payee_name": null,
+        "import_payee_name_original": null,
+        "debt_transaction_type": null
       },
       {
         "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd7",
@@ -804,13 +799,68 @@ func TestService_CreateTransactions(t *testing.T) {
         "transfer_account_id": null,
         "import_id": null,
         "deleted": false,
-        "subtransactions": []
+        "subtransactions": [],
+        "transfer_transaction_id": null,
+        "matched_transaction_id": null,
+        "import_payee_name": null,
+        "import_payee_name_original": null,
+        "debt_transaction_type": null
       }
     ]
   }
-}
-		`)
-			res.Header.Add("X-Rate-Limit", "36/200")
+}`
+
+func TestService_CreateTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
+	payloadPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
+	payloadPayeeName := "bla bla bla"
+	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	payloadMemo := "nice memo"
+	payloadFlagColor := transaction.FlagColorBlue
+
+	payload := []transaction.PayloadTransaction{
+		{
+			AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			Date:       payloadDate,
+			Amount:     int64(-9000),
+			Cleared:    transaction.ClearingStatusCleared,
+			Approved:   true,
+			PayeeID:    &payloadPayeeID,
+			PayeeName:  &payloadPayeeName,
+			CategoryID: &payloadCategoryID,
+			Memo:       &payloadMemo,
+			FlagColor:  &payloadFlagColor,
+		},
+		{
+			AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+			Date:       payloadDate,
+			Amount:     int64(-2000),
+			Cleared:    transaction.ClearingStatusUncleared,
+			Approved:   false,
+			PayeeID:    &payloadPayeeID,
+			PayeeName:  &payloadPayeeName,
+			CategoryID: &payloadCategoryID,
+			Memo:       &payloadMemo,
+			FlagColor:  &payloadFlagColor,
+		},
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			resModel := struct {
+				Data *transaction.OperationSummary `json:"data"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&resModel)
+			assert.NoError(t, err)
+
+			res := httpmock.NewStringResponse(200, createTransactionsResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
 	)
@@ -918,53 +968,7 @@ func TestService_UpdateTransactions(t *testing.T) {
 			err := json.NewDecoder(req.Body).Decode(&resModel)
 			assert.NoError(t, err)
 
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-		"transaction_ids": ["0f5b3f73-ded2-4dd7-8b01-c23022622cd6", "0f5b3f73-ded2-4dd7-8b01-c23022622cd7"],
-		"duplicate_import_ids": [],
-    "transactions": [
-      {
-        "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-        "date": "2018-11-13",
-        "amount": -9000,
-        "memo": "nice memo",
-        "cleared": "cleared",
-        "approved": true,
-        "flag_color": "blue",
-        "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-        "account_name": "Bank Name",
-        "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-        "payee_name": "bla bla bla",
-        "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-        "category_name": "Groceries",
-        "transfer_account_id": null,
-        "import_id": null,
-        "deleted": false,
-        "subtransactions": []
-      },
-      {
-        "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd7",
-        "date": "2018-11-13",
-        "amount": -2000,
-        "memo": "nice memo",
-        "cleared": "uncleared",
-        "approved": false,
-        "flag_color": "blue",
-        "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
-        "account_name": "Bank Name",
-        "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-        "payee_name": "bla bla bla",
-        "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-        "category_name": "Groceries",
-        "transfer_account_id": null,
-        "import_id": null,
-        "deleted": false,
-        "subtransactions": []
-      }
-    ]
-  }
-}
-		`)
+			res := httpmock.NewStringResponse(200, createTransactionsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -1022,6 +1026,16 @@ func TestService_UpdateTransactions(t *testing.T) {
 	assert.Equal(t, expectedTransactions, tx)
 }
 
+// bulkCreateTransactionsResponse an API response served by TestService_BulkCreateTransactions
+const bulkCreateTransactionsResponse = `{
+  "data": {
+    "bulk": {
+      "transaction_ids": ["aaaaa321-eed7-4575-a990-717386438d2c"],
+      "duplicate_import_ids": ["asdfg"]
+    }
+	}
+}`
+
 func TestService_BulkCreateTransactions(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -1075,15 +1089,7 @@ func TestService_BulkCreateTransactions(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, payload, resModel.Transactions)
 
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "bulk": {
-      "transaction_ids": ["aaaaa321-eed7-4575-a990-717386438d2c"],
-      "duplicate_import_ids": ["asdfg"]
-    }
-	}
-}
-		`)
+			res := httpmock.NewStringResponse(200, bulkCreateTransactionsResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -1105,6 +1111,36 @@ func TestService_BulkCreateTransactions(t *testing.T) {
 	assert.Equal(t, expectedBunk, bulk)
 }
 
+// updateTransactionResponse an API response served by TestService_UpdateTransaction
+const updateTransactionResponse = `{
+  "data": {
+    "transaction": {
+      "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "date": "2018-11-13",
+      "amount": -100000,
+      "memo": null,
+      "cleared": "cleared",
+      "approved": true,
+      "flag_color": null,
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+      "payee_name": "bla bla bla",
+      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+      "category_name": "Groceries",
+      "transfer_account_id": null,
+      "import_id": null,
+      "deleted": false,
+      "subtransactions": [],
+      "transfer_transaction_id": null,
+      "matched_transaction_id": null,
+      "import_payee_name": null,
+      "import_payee_name_original": null,
+      "debt_transaction_type": null
+    }
+  }
+}`
+
 func TestService_UpdateTransaction(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -1139,7 +1175,43 @@ func TestService_UpdateTransaction(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, &payload, resModel.Transaction)
 
-			res := httpmock.NewStringResponse(200, `{
+			res := httpmock.NewStringResponse(200, updateTransactionResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	tx, err := client.Transaction().UpdateTransaction(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+		payload,
+	)
+	assert.NoError(t, err)
+
+	expectedCategoryName := "Groceries"
+	expectedTransaction := &transaction.Transaction{
+		ID:              "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+		Date:            payload.Date,
+		Amount:          payload.Amount,
+		Cleared:         payload.Cleared,
+		Approved:        payload.Approved,
+		AccountID:       payload.AccountID,
+		AccountName:     "Bank Name",
+		PayeeID:         payload.PayeeID,
+		PayeeName:       payload.PayeeName,
+		CategoryID:      payload.CategoryID,
+		CategoryName:    &expectedCategoryName,
+		Deleted:         false,
+		SubTransactions: []*transaction.SubTransaction{},
+	}
+
+	assert.Equal(t, expectedTransaction, tx)
+}
+
+// deleteTransactionResponse an API response served by TestService_DeleteTransaction
+const deleteTransactionResponse = `{
   "data": {
     "transaction": {
       "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
@@ -1151,50 +1223,414 @@ func TestService_UpdateTransaction(t *testing.T) {
       "flag_color": null,
       "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
       "account_name": "Bank Name",
-      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
-      "payee_name": "bla bla bla",
-      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
-      "category_name": "Groceries",
+      "payee_id": null,
+      "payee_name": null,
+      "category_id": null,
+      "category_name": null,
       "transfer_account_id": null,
       "import_id": null,
-      "deleted": false,
-      "subtransactions": []
+      "deleted": true,
+      "subtransactions": [],
+      "transfer_transaction_id": null,
+      "matched_transaction_id": null,
+      "import_payee_name": null,
+      "import_payee_name_original": null,
+      "debt_transaction_type": null
     }
-	}
-}
-		`)
+  }
+}`
+
+func TestService_DeleteTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/0f5b3f73-ded2-4dd7-8b01-c23022622cd6"
+	httpmock.RegisterResponder(http.MethodDelete, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, deleteTransactionResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
 	)
 
 	client := ynab.NewClient("")
-	tx, err := client.Transaction().UpdateTransaction(
+	tx, err := client.Transaction().DeleteTransaction(
 		context.Background(),
 		"aa248caa-eed7-4575-a990-717386438d2c",
 		"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-		payload,
 	)
 	assert.NoError(t, err)
 
-	expectedCategoryName := "Groceries"
+	expectedDate, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+
 	expectedTransaction := &transaction.Transaction{
 		ID:              "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
-		Date:            payload.Date,
+		Date:            expectedDate,
+		Amount:          int64(-100000),
+		Cleared:         transaction.ClearingStatusCleared,
+		Approved:        true,
+		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:     "Bank Name",
+		Deleted:         true,
+		SubTransactions: []*transaction.SubTransaction{},
+	}
+	assert.Equal(t, expectedTransaction, tx)
+}
+
+func TestService_DeleteTransactions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/"
+	for _, id := range []string{"0f5b3f73-ded2-4dd7-8b01-c23022622cd6", "e6ad88f5-6f16-4480-9515-5377012750dd"} {
+		id := id
+		httpmock.RegisterResponder(http.MethodDelete, url+id,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "transaction": {
+      "id": "`+id+`",
+      "date": "2018-11-13",
+      "amount": -100000,
+      "deleted": true
+    }
+	}
+}
+		`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+	}
+	httpmock.RegisterResponder(http.MethodDelete, url+"9453526b-2f58-4c02-9683-a30c2a1192d7",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(404, `{
+  "error": {
+    "id": "404.2",
+    "name": "resource_not_found",
+    "detail": "Resource not found"
+  }
+}`), nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	txs, err := client.Transaction().DeleteTransactions(
+		context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{
+			"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+			"9453526b-2f58-4c02-9683-a30c2a1192d7",
+			"e6ad88f5-6f16-4480-9515-5377012750dd",
+		},
+	)
+	assert.EqualError(t, err, "transaction: 1 operation(s) failed: "+
+		"9453526b-2f58-4c02-9683-a30c2a1192d7: api: error id=404.2 name=resource_not_found detail=Resource not found")
+
+	batchErr, ok := err.(*transaction.BatchError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"9453526b-2f58-4c02-9683-a30c2a1192d7"}, batchErr.FailedIDs())
+	assert.True(t, errors.Is(batchErr.Failures[0].Err, api.ErrNotFound))
+
+	assert.Len(t, txs, 2)
+	assert.Equal(t, "0f5b3f73-ded2-4dd7-8b01-c23022622cd6", txs[0].ID)
+	assert.Equal(t, "e6ad88f5-6f16-4480-9515-5377012750dd", txs[1].ID)
+	assert.True(t, txs[0].Deleted)
+}
+
+// deleteTransactionsCancelledResponse an API response served by TestService_DeleteTransactions_cancelled
+const deleteTransactionsCancelledResponse = `{
+  "data": {
+    "transaction": {
+      "id": "0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+      "date": "2018-11-13",
+      "amount": -100000,
+      "deleted": true,
+      "cleared": "",
+      "approved": false,
+      "account_id": "",
+      "account_name": "",
+      "subtransactions": null,
+      "memo": null,
+      "flag_color": null,
+      "payee_id": null,
+      "category_id": null,
+      "transfer_account_id": null,
+      "transfer_transaction_id": null,
+      "matched_transaction_id": null,
+      "import_id": null,
+      "import_payee_name": null,
+      "import_payee_name_original": null,
+      "debt_transaction_type": null,
+      "payee_name": null,
+      "category_name": null
+    }
+  }
+}`
+
+func TestService_DeleteTransactions_cancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/transactions/"
+	httpmock.RegisterResponder(http.MethodDelete, url+"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+		func(req *http.Request) (*http.Response, error) {
+			// the context is cancelled while the first deletion is in flight
+			cancel()
+			res := httpmock.NewStringResponse(200, deleteTransactionsCancelledResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	txs, err := client.Transaction().DeleteTransactions(ctx, "aa248caa-eed7-4575-a990-717386438d2c",
+		[]string{
+			"0f5b3f73-ded2-4dd7-8b01-c23022622cd6",
+			"9453526b-2f58-4c02-9683-a30c2a1192d7",
+			"e6ad88f5-6f16-4480-9515-5377012750dd",
+		},
+	)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	batchErr, ok := err.(*transaction.BatchError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{
+		"9453526b-2f58-4c02-9683-a30c2a1192d7",
+		"e6ad88f5-6f16-4480-9515-5377012750dd",
+	}, batchErr.FailedIDs())
+	for _, f := range batchErr.Failures {
+		assert.True(t, errors.Is(f.Err, context.Canceled))
+	}
+}
+
+func TestService_CreateScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString(time.Now().AddDate(0, 1, 0).Format("2006-01-02"))
+	assert.NoError(t, err)
+
+	payloadPayeeName := "Streaming service"
+	payloadCategoryID := "f3cc4f55-312a-4bcd-89c4-db34379cb1dc"
+	payloadMemo := "nice memo"
+	payloadFlagColor := transaction.FlagColorPurple
+
+	payload := transaction.PayloadScheduledTransaction{
+		AccountID:  "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		DateFirst:  payloadDate,
+		Frequency:  transaction.FrequencyMonthly,
+		Amount:     int64(-9990),
+		PayeeName:  &payloadPayeeName,
+		CategoryID: &payloadCategoryID,
+		Memo:       &payloadMemo,
+		FlagColor:  &payloadFlagColor,
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions"
+	httpmock.RegisterResponder(http.MethodPost, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				ScheduledTransaction *transaction.PayloadScheduledTransaction `json:"scheduled_transaction"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, reqModel.ScheduledTransaction)
+
+			res := httpmock.NewStringResponse(201, `{
+  "data": {
+    "scheduled_transaction": {
+      "id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+      "date_first": "`+api.DateFormat(payloadDate)+`",
+      "date_next": "`+api.DateFormat(payloadDate)+`",
+      "frequency": "monthly",
+      "amount": -9990,
+      "memo": "nice memo",
+      "flag_color": "purple",
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "payee_id": "0d0e928d-312a-4bcd-89c4-e02f40d1fe46",
+      "payee_name": "Streaming service",
+      "category_id": "f3cc4f55-312a-4bcd-89c4-db34379cb1dc",
+      "category_name": "Subscriptions",
+      "transfer_account_id": null,
+      "deleted": false,
+      "subtransactions": []
+    }
+  }
+}
+		`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().CreateScheduledTransaction(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", payload)
+	assert.NoError(t, err)
+
+	expectedPayeeID := "0d0e928d-312a-4bcd-89c4-e02f40d1fe46"
+	expectedCategoryName := "Subscriptions"
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       payloadDate,
+		DateNext:        payloadDate,
+		Frequency:       transaction.FrequencyMonthly,
 		Amount:          payload.Amount,
-		Cleared:         payload.Cleared,
-		Approved:        payload.Approved,
+		Memo:            payload.Memo,
+		FlagColor:       payload.FlagColor,
 		AccountID:       payload.AccountID,
 		AccountName:     "Bank Name",
-		PayeeID:         payload.PayeeID,
+		PayeeID:         &expectedPayeeID,
 		PayeeName:       payload.PayeeName,
 		CategoryID:      payload.CategoryID,
 		CategoryName:    &expectedCategoryName,
 		Deleted:         false,
-		SubTransactions: []*transaction.SubTransaction{},
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
 	}
+	assert.Equal(t, expected, stx)
+}
 
-	assert.Equal(t, expectedTransaction, tx)
+func TestService_CreateScheduledTransaction_invalidPayload(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := ynab.NewClient("")
+	_, err := client.Transaction().CreateScheduledTransaction(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", transaction.PayloadScheduledTransaction{
+			Frequency: "fortnightly",
+		})
+	assert.EqualError(t, err, `transaction: invalid scheduled transaction frequency: "fortnightly"`)
+}
+
+func TestService_UpdateScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	payloadDate, err := api.DateFromString(time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	assert.NoError(t, err)
+
+	payload := transaction.PayloadScheduledTransaction{
+		AccountID: "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		DateFirst: payloadDate,
+		Frequency: transaction.FrequencyYearly,
+		Amount:    int64(-99000),
+	}
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodPut, url,
+		func(req *http.Request) (*http.Response, error) {
+			reqModel := struct {
+				ScheduledTransaction *transaction.PayloadScheduledTransaction `json:"scheduled_transaction"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&reqModel)
+			assert.NoError(t, err)
+			assert.Equal(t, &payload, reqModel.ScheduledTransaction)
+
+			res := httpmock.NewStringResponse(200, `{
+  "data": {
+    "scheduled_transaction": {
+      "id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+      "date_first": "`+api.DateFormat(payloadDate)+`",
+      "date_next": "`+api.DateFormat(payloadDate)+`",
+      "frequency": "yearly",
+      "amount": -99000,
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "deleted": false,
+      "subtransactions": []
+    }
+  }
+}
+		`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().UpdateScheduledTransaction(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", "56f4fc86-2ed7-4b3b-9116-7a214261b3cd", payload)
+	assert.NoError(t, err)
+
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       payloadDate,
+		DateNext:        payloadDate,
+		Frequency:       transaction.FrequencyYearly,
+		Amount:          payload.Amount,
+		AccountID:       payload.AccountID,
+		AccountName:     "Bank Name",
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	}
+	assert.Equal(t, expected, stx)
+}
+
+// deleteScheduledTransactionResponse an API response served by TestService_DeleteScheduledTransaction
+const deleteScheduledTransactionResponse = `{
+  "data": {
+    "scheduled_transaction": {
+      "id": "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+      "date_first": "2018-11-13",
+      "date_next": "2018-12-13",
+      "frequency": "monthly",
+      "amount": -9990,
+      "account_id": "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+      "account_name": "Bank Name",
+      "deleted": true,
+      "subtransactions": [],
+      "memo": null,
+      "flag_color": null,
+      "payee_id": null,
+      "category_id": null,
+      "transfer_account_id": null,
+      "payee_name": null,
+      "category_name": null
+    }
+  }
+}`
+
+func TestService_DeleteScheduledTransaction(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://api.youneedabudget.com/v1/budgets/aa248caa-eed7-4575-a990-717386438d2c/scheduled_transactions/56f4fc86-2ed7-4b3b-9116-7a214261b3cd"
+	httpmock.RegisterResponder(http.MethodDelete, url,
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(200, deleteScheduledTransactionResponse)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	client := ynab.NewClient("")
+	stx, err := client.Transaction().DeleteScheduledTransaction(context.Background(),
+		"aa248caa-eed7-4575-a990-717386438d2c", "56f4fc86-2ed7-4b3b-9116-7a214261b3cd")
+	assert.NoError(t, err)
+
+	expectedDateFirst, err := api.DateFromString("2018-11-13")
+	assert.NoError(t, err)
+	expectedDateNext, err := api.DateFromString("2018-12-13")
+	assert.NoError(t, err)
+
+	expected := &transaction.Scheduled{
+		ID:              "56f4fc86-2ed7-4b3b-9116-7a214261b3cd",
+		DateFirst:       expectedDateFirst,
+		DateNext:        expectedDateNext,
+		Frequency:       transaction.FrequencyMonthly,
+		Amount:          int64(-9990),
+		AccountID:       "09eaca5e-312a-4bcd-89c4-828fb90638f2",
+		AccountName:     "Bank Name",
+		Deleted:         true,
+		SubTransactions: []*transaction.ScheduledSubTransaction{},
+	}
+	assert.Equal(t, expected, stx)
 }
 
 func TestFilter_ToQuery(t *testing.T) {
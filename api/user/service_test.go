@@ -16,20 +16,22 @@ import (
 	"github.com/mellis/ynab.go/api/user"
 )
 
+// getUserResponse an API response served by TestService_GetUser
+const getUserResponse = `{
+  "data": {
+    "user": {
+      "id": "aa248caa-eed7-4575-a990-717386438d2c"
+    }
+  }
+}`
+
 func TestService_GetUser(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder(http.MethodGet, "https://api.youneedabudget.com/v1/user",
 		func(req *http.Request) (*http.Response, error) {
-			res := httpmock.NewStringResponse(200, `{
-  "data": {
-    "user": {
-      "id": "aa248caa-eed7-4575-a990-717386438d2c"
-    }
-  }
-}
-		`)
+			res := httpmock.NewStringResponse(200, getUserResponse)
 			res.Header.Add("X-Rate-Limit", "36/200")
 			return res, nil
 		},
@@ -0,0 +1,97 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budgeting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mellis/ynab.go/api/category"
+)
+
+// rollbackTimeout bounds the rollback of the assignments of a failed plan
+const rollbackTimeout = 30 * time.Second
+
+// Result represents the outcome of applying a plan
+type Result struct {
+	Plan *Plan
+	// Assignments the outcome of each assignment attempted, in plan order.
+	// Assignments after a failure are not attempted
+	Assignments []*AssignmentResult
+}
+
+// AssignmentResult represents the outcome of an assignment
+type AssignmentResult struct {
+	*Assignment
+
+	// Category the category as updated by the assignment
+	Category *category.Category
+	// Err the error assigning the amount, nil on success
+	Err error
+	// RolledBack whether the category was restored to its previous
+	// budgeted amount after a later assignment failed
+	RolledBack bool
+	// RollbackErr the error restoring the category, which is left with
+	// the assigned amount
+	RollbackErr error
+}
+
+// Apply assigns the amounts of a plan one category at a time. Should an
+// assignment fail, the categories already assigned are restored to their
+// previous budgeted amount, also once ctx is done, and the error is
+// returned along with the result describing what was assigned and rolled
+// back
+func (a *Assigner) Apply(ctx context.Context, p *Plan) (*Result, error) {
+	res := &Result{Plan: p}
+	for _, as := range p.Assignments {
+		if as.Amount == 0 {
+			continue
+		}
+
+		c, err := a.c.Category().UpdateCategoryAt(ctx, p.BudgetID, as.CategoryID, p.Month,
+			category.PayloadMonthCategory{Budgeted: as.Budgeted + as.Amount})
+		res.Assignments = append(res.Assignments, &AssignmentResult{Assignment: as, Category: c, Err: err})
+		if err != nil {
+			return res, a.rollback(ctx, res, as, err)
+		}
+	}
+	return res, nil
+}
+
+// rollback restores the categories assigned before a failed assignment.
+// The restores run on their own deadline, as the failure may be caused by
+// the cancellation of ctx
+func (a *Assigner) rollback(ctx context.Context, res *Result, failed *Assignment, cause error) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	p := res.Plan
+	rolledBack, kept := 0, 0
+	for i := len(res.Assignments) - 1; i >= 0; i-- {
+		ar := res.Assignments[i]
+		if ar.Err != nil {
+			continue
+		}
+
+		c, err := a.c.Category().UpdateCategoryAt(ctx, p.BudgetID, ar.CategoryID, p.Month,
+			category.PayloadMonthCategory{Budgeted: ar.Budgeted})
+		if err != nil {
+			ar.RollbackErr = err
+			kept++
+			continue
+		}
+		ar.Category = c
+		ar.RolledBack = true
+		rolledBack++
+	}
+
+	if kept > 0 {
+		return fmt.Errorf("budgeting: assigning category %s: %w (%d assignment(s) rolled back, %d could not be)",
+			failed.CategoryName, cause, rolledBack, kept)
+	}
+	return fmt.Errorf("budgeting: assigning category %s: %w (%d assignment(s) rolled back)",
+		failed.CategoryName, cause, rolledBack)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package budgeting implements helpers assigning money to the categories
+// of a budget month
+package budgeting // import "github.com/mellis/ynab.go/budgeting"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+)
+
+// NewAssigner facilitates the creation of a new assigner instance
+func NewAssigner(c ynab.ClientServicer) *Assigner {
+	return &Assigner{c: c}
+}
+
+// Assigner distributes the amount to be budgeted of a month across its
+// categories
+type Assigner struct {
+	c ynab.ClientServicer
+}
+
+// Plan represents the assignments a strategy proposes for a month, to be
+// reviewed before it is applied
+type Plan struct {
+	BudgetID string
+	Month    api.Month
+	// ToBeBudgeted the amount available to assign before the plan
+	ToBeBudgeted int64
+	// Unassigned the amount left to be budgeted after the plan
+	Unassigned  int64
+	Assignments []*Assignment
+}
+
+// Assignment represents an amount added to a category by a plan
+type Assignment struct {
+	CategoryID   string
+	CategoryName string
+	// Budgeted the budgeted amount of the category before the plan
+	Budgeted int64
+	// Requested the amount the strategy asked for
+	Requested int64
+	// Amount the amount added, less than Requested when the amount to be
+	// budgeted ran out
+	Amount int64
+}
+
+// String describes the plan one assignment per line
+func (p *Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s to be budgeted\n", p.Month, api.Milliunits(p.ToBeBudgeted))
+	for _, a := range p.Assignments {
+		fmt.Fprintf(&b, "%s: %s + %s = %s", a.CategoryName, api.Milliunits(a.Budgeted),
+			api.Milliunits(a.Amount), api.Milliunits(a.Budgeted+a.Amount))
+		if a.Amount < a.Requested {
+			fmt.Fprintf(&b, " (%s requested)", api.Milliunits(a.Requested))
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%s left to be budgeted\n", api.Milliunits(p.Unassigned))
+	return b.String()
+}
+
+// Plan computes the assignments of a strategy for a month. Nothing is
+// assigned until the plan is applied
+func (a *Assigner) Plan(ctx context.Context, budgetID string, at api.Month, s Strategy) (*Plan, error) {
+	m, err := a.c.Month().GetMonthAt(ctx, budgetID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Request{
+		BudgetID: budgetID,
+		Month:    at,
+		months:   map[api.Month]*month.Month{at: m},
+		fetch: func(ctx context.Context, m api.Month) (*month.Month, error) {
+			return a.c.Month().GetMonthAt(ctx, budgetID, m)
+		},
+	}
+	categories := make(map[string]*category.Category, len(m.Categories))
+	for _, c := range m.Categories {
+		if c.Hidden || c.Deleted {
+			continue
+		}
+		r.Categories = append(r.Categories, c)
+		categories[c.ID] = c
+	}
+
+	targets, err := s.Targets(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Plan{BudgetID: budgetID, Month: at}
+	if m.ToBeBudgeted != nil {
+		p.ToBeBudgeted = *m.ToBeBudgeted
+	}
+	p.Unassigned = p.ToBeBudgeted
+
+	assignments := make(map[string]*Assignment)
+	for _, t := range targets {
+		c, ok := categories[t.CategoryID]
+		if !ok || t.Amount <= 0 {
+			continue
+		}
+
+		amount := t.Amount
+		if amount > p.Unassigned {
+			amount = p.Unassigned
+		}
+		if amount < 0 {
+			amount = 0
+		}
+
+		as, ok := assignments[c.ID]
+		if !ok {
+			as = &Assignment{CategoryID: c.ID, CategoryName: c.Name, Budgeted: c.Budgeted}
+			assignments[c.ID] = as
+			p.Assignments = append(p.Assignments, as)
+		}
+		as.Requested += t.Amount
+		as.Amount += amount
+		p.Unassigned -= amount
+	}
+	return p, nil
+}
+
+// AutoAssign plans and applies the assignments of a strategy for a month
+func (a *Assigner) AutoAssign(ctx context.Context, budgetID string, at api.Month, s Strategy) (*Result, error) {
+	p, err := a.Plan(ctx, budgetID, at, s)
+	if err != nil {
+		return nil, err
+	}
+	return a.Apply(ctx, p)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budgeting_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/budgeting"
+)
+
+const (
+	budgetID  = "aa248caa-eed7-4575-a990-717386438d2c"
+	budgetURL = "https://api.youneedabudget.com/v1/budgets/" + budgetID
+)
+
+var may = api.NewMonth(2018, time.May)
+
+// registerMonths registers May 2018 with 100.00 to be budgeted, and the
+// two previous months
+func registerMonths() {
+	bodies := map[string]string{
+		"2018-05-01": `{"data":{"month":{"month":"2018-05-01","to_be_budgeted":100000,"categories":[
+			{"id":"cat-1","name":"Rent","budgeted":0,"balance":0,"goal_type":"MF","goal_target":80000},
+			{"id":"cat-2","name":"Groceries","budgeted":10000,"balance":10000,"goal_type":"NEED",
+			 "goal_target":50000,"goal_months_to_budget":1},
+			{"id":"cat-3","name":"Fun","budgeted":0,"balance":0},
+			{"id":"cat-4","name":"Old","hidden":true,"budgeted":0,"goal_type":"MF","goal_target":1000}
+		]}}}`,
+		"2018-04-01": `{"data":{"month":{"month":"2018-04-01","to_be_budgeted":0,"categories":[
+			{"id":"cat-1","name":"Rent","budgeted":80000,"activity":-80000},
+			{"id":"cat-2","name":"Groceries","budgeted":45000,"activity":-45000},
+			{"id":"cat-3","name":"Fun","budgeted":15000,"activity":-12000},
+			{"id":"cat-5","name":"Inflow: To be Budgeted","budgeted":0,"activity":300000}
+		]}}}`,
+		"2018-03-01": `{"data":{"month":{"month":"2018-03-01","to_be_budgeted":0,"categories":[
+			{"id":"cat-1","name":"Rent","budgeted":80000,"activity":-80000},
+			{"id":"cat-2","name":"Groceries","budgeted":35000,"activity":-35000},
+			{"id":"cat-3","name":"Fun","budgeted":30000,"activity":-30000}
+		]}}}`,
+	}
+	for m, body := range bodies {
+		body := body
+		httpmock.RegisterResponder(http.MethodGet, budgetURL+"/months/"+m,
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusOK, body)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+	}
+}
+
+// registerUpdates registers the month category updates of May 2018,
+// recording the budgeted amounts sent. Updates of the failing category
+// fail
+func registerUpdates(t *testing.T, failing string) *[]string {
+	var updates []string
+	for _, id := range []string{"cat-1", "cat-2", "cat-3"} {
+		id := id
+		httpmock.RegisterResponder(http.MethodPut, budgetURL+"/months/2018-05-01/categories/"+id,
+			func(req *http.Request) (*http.Response, error) {
+				if err := req.Context().Err(); err != nil {
+					return nil, err
+				}
+				if id == failing {
+					return httpmock.NewStringResponse(http.StatusInternalServerError,
+						`{"error":{"id":"500","name":"internal_server_error","detail":"Something went wrong"}}`), nil
+				}
+
+				payload := struct {
+					MonthCategory category.PayloadMonthCategory `json:"month_category"`
+				}{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				budgeted := payload.MonthCategory.Budgeted
+				updates = append(updates, fmt.Sprintf("%s=%d", id, budgeted))
+
+				res := httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(
+					`{"data":{"category":{"id":%q,"budgeted":%d}}}`, id, budgeted))
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+	}
+	return &updates
+}
+
+// summary describes the assignments of a plan as id=amount/requested
+func summary(p *budgeting.Plan) []string {
+	var s []string
+	for _, a := range p.Assignments {
+		s = append(s, fmt.Sprintf("%s=%d/%d", a.CategoryID, a.Amount, a.Requested))
+	}
+	return s
+}
+
+func TestAssigner_Plan(t *testing.T) {
+	table := []struct {
+		Name       string
+		Strategy   budgeting.Strategy
+		Expected   []string
+		Unassigned int64
+		Calls      int
+	}{
+		{
+			Name:     "underfunded goals",
+			Strategy: budgeting.UnderfundedGoals(),
+			Expected: []string{"cat-1=80000/80000", "cat-2=20000/40000"},
+			Calls:    1,
+		},
+		{
+			Name:     "last month budgeted",
+			Strategy: budgeting.LastMonthBudgeted(),
+			Expected: []string{"cat-1=80000/80000", "cat-2=20000/35000", "cat-3=0/15000"},
+			Calls:    2,
+		},
+		{
+			Name:     "average spent",
+			Strategy: budgeting.AverageSpent(2),
+			Expected: []string{"cat-1=80000/80000", "cat-2=20000/30000", "cat-3=0/21000"},
+			Calls:    3,
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.Name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+			registerMonths()
+
+			a := budgeting.NewAssigner(ynab.NewClient(""))
+			p, err := a.Plan(context.Background(), budgetID, may, test.Strategy)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100000), p.ToBeBudgeted)
+			assert.Equal(t, test.Unassigned, p.Unassigned)
+			assert.Equal(t, test.Expected, summary(p))
+			assert.Equal(t, test.Calls, httpmock.GetTotalCallCount())
+		})
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerMonths()
+
+	a := budgeting.NewAssigner(ynab.NewClient(""))
+	p, err := a.Plan(context.Background(), budgetID, may, budgeting.UnderfundedGoals())
+	assert.NoError(t, err)
+	assert.Equal(t, `2018-05-01: 100 to be budgeted
+Rent: 0 + 80 = 80
+Groceries: 10 + 20 = 30 (40 requested)
+0 left to be budgeted
+`, p.String())
+}
+
+func TestAssigner_AutoAssign(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerMonths()
+	updates := registerUpdates(t, "")
+
+	a := budgeting.NewAssigner(ynab.NewClient(""))
+	res, err := a.AutoAssign(context.Background(), budgetID, may, budgeting.LastMonthBudgeted())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cat-1=80000", "cat-2=30000"}, *updates)
+
+	// the category without amount is not updated
+	assert.Len(t, res.Assignments, 2)
+	for _, ar := range res.Assignments {
+		assert.NoError(t, ar.Err)
+		assert.False(t, ar.RolledBack)
+	}
+	assert.Equal(t, int64(30000), res.Assignments[1].Category.Budgeted)
+}
+
+func TestAssigner_Apply_rollback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerMonths()
+	updates := registerUpdates(t, "cat-3")
+
+	a := budgeting.NewAssigner(ynab.NewClient(""))
+	p, err := a.Plan(context.Background(), budgetID, may, budgeting.UnderfundedGoals())
+	assert.NoError(t, err)
+
+	// funding Fun as well, which fails
+	p.Assignments[1].Amount = 10000
+	p.Assignments = append(p.Assignments, &budgeting.Assignment{
+		CategoryID: "cat-3", CategoryName: "Fun", Amount: 10000, Requested: 10000,
+	})
+
+	res, err := a.Apply(context.Background(), p)
+	assert.True(t, errors.Is(err, api.ErrInternal))
+	assert.True(t, strings.HasPrefix(err.Error(), "budgeting: assigning category Fun: "), err.Error())
+	assert.True(t, strings.HasSuffix(err.Error(), "(2 assignment(s) rolled back)"), err.Error())
+
+	// assigned, then restored in reverse order
+	assert.Equal(t, []string{"cat-1=80000", "cat-2=20000", "cat-2=10000", "cat-1=0"}, *updates)
+
+	assert.Len(t, res.Assignments, 3)
+	assert.True(t, res.Assignments[0].RolledBack)
+	assert.True(t, res.Assignments[1].RolledBack)
+	assert.Equal(t, int64(10000), res.Assignments[1].Category.Budgeted)
+	assert.Error(t, res.Assignments[2].Err)
+	assert.False(t, res.Assignments[2].RolledBack)
+}
+
+func TestAssigner_Apply_rollbackCancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerMonths()
+	updates := registerUpdates(t, "")
+
+	a := budgeting.NewAssigner(ynab.NewClient(""))
+	p, err := a.Plan(context.Background(), budgetID, may, budgeting.UnderfundedGoals())
+	assert.NoError(t, err)
+
+	// the deadline is hit while assigning the second category
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	httpmock.RegisterResponder(http.MethodPut, budgetURL+"/months/2018-05-01/categories/cat-2",
+		func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return nil, context.DeadlineExceeded
+		},
+	)
+	p.Assignments[1].Amount = 10000
+
+	res, err := a.Apply(ctx, p)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, strings.HasSuffix(err.Error(), "(1 assignment(s) rolled back)"), err.Error())
+	assert.Equal(t, []string{"cat-1=80000", "cat-1=0"}, *updates)
+	assert.True(t, res.Assignments[0].RolledBack)
+	assert.NoError(t, res.Assignments[0].RollbackErr)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budgeting_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/budgeting"
+)
+
+func ExampleAssigner_Plan() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	a := budgeting.NewAssigner(c)
+	p, err := a.Plan(context.Background(), "<valid_budget_id>", api.CurrentMonth(nil), budgeting.UnderfundedGoals())
+	if err != nil {
+		return
+	}
+
+	// review the plan before applying it
+	fmt.Print(p)
+	a.Apply(context.Background(), p) //nolint:errcheck
+}
+
+func ExampleAssigner_AutoAssign() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	a := budgeting.NewAssigner(c)
+	res, _ := a.AutoAssign(context.Background(), "<valid_budget_id>", api.CurrentMonth(nil), budgeting.AverageSpent(3))
+	fmt.Println(reflect.TypeOf(res))
+
+	// Output: *budgeting.Result
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package budgeting
+
+import (
+	"context"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+)
+
+// Target represents an amount a strategy wants assigned to a category on
+// top of its budgeted amount, in milliunits
+type Target struct {
+	CategoryID string
+	Amount     int64
+}
+
+// Strategy contract for deciding how much to assign to each category.
+// Targets are funded in the order they are returned, until the amount to
+// be budgeted runs out
+type Strategy interface {
+	Targets(ctx context.Context, r *Request) ([]Target, error)
+}
+
+// Request represents the inputs of a strategy
+type Request struct {
+	BudgetID string
+	Month    api.Month
+	// Categories the visible categories of the month
+	Categories []*category.Category
+
+	months map[api.Month]*month.Month
+	fetch  func(ctx context.Context, m api.Month) (*month.Month, error)
+}
+
+// MonthAt returns another month of the budget, such as a previous month.
+// Months are fetched once per plan
+func (r *Request) MonthAt(ctx context.Context, m api.Month) (*month.Month, error) {
+	if mm, ok := r.months[m]; ok {
+		return mm, nil
+	}
+
+	mm, err := r.fetch(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	r.months[m] = mm
+	return mm, nil
+}
+
+// UnderfundedGoals returns a strategy funding what each category needs
+// this month to stay on track towards its goal
+func UnderfundedGoals() Strategy {
+	return underfundedGoals{}
+}
+
+type underfundedGoals struct{}
+
+func (underfundedGoals) Targets(ctx context.Context, r *Request) ([]Target, error) {
+	var targets []Target
+	for _, c := range r.Categories {
+		if needed := c.NeededThisMonth(r.Month); needed > 0 {
+			targets = append(targets, Target{CategoryID: c.ID, Amount: needed})
+		}
+	}
+	return targets, nil
+}
+
+// LastMonthBudgeted returns a strategy assigning each category what was
+// budgeted to it in the previous month
+func LastMonthBudgeted() Strategy {
+	return lastMonthBudgeted{}
+}
+
+type lastMonthBudgeted struct{}
+
+func (lastMonthBudgeted) Targets(ctx context.Context, r *Request) ([]Target, error) {
+	prev, err := r.MonthAt(ctx, r.Month.Prev())
+	if err != nil {
+		return nil, err
+	}
+
+	budgeted := make(map[string]int64, len(prev.Categories))
+	for _, c := range prev.Categories {
+		budgeted[c.ID] = c.Budgeted
+	}
+
+	var targets []Target
+	for _, c := range r.Categories {
+		if amount := budgeted[c.ID] - c.Budgeted; amount > 0 {
+			targets = append(targets, Target{CategoryID: c.ID, Amount: amount})
+		}
+	}
+	return targets, nil
+}
+
+// AverageSpent returns a strategy assigning each category the average it
+// spent over the previous n months, rounded up to the milliunit
+func AverageSpent(n int) Strategy {
+	if n < 1 {
+		n = 1
+	}
+	return averageSpent{n: n}
+}
+
+type averageSpent struct {
+	n int
+}
+
+func (s averageSpent) Targets(ctx context.Context, r *Request) ([]Target, error) {
+	spent := make(map[string]int64)
+	for i := 1; i <= s.n; i++ {
+		m, err := r.MonthAt(ctx, r.Month.AddMonths(-i))
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range m.Categories {
+			if c.Activity < 0 {
+				spent[c.ID] -= c.Activity
+			}
+		}
+	}
+
+	var targets []Target
+	for _, c := range r.Categories {
+		average := (spent[c.ID] + int64(s.n) - 1) / int64(s.n)
+		if amount := average - c.Budgeted; amount > 0 {
+			targets = append(targets, Target{CategoryID: c.ID, Amount: amount})
+		}
+	}
+	return targets, nil
+}
@@ -9,10 +9,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/mellis/ynab.go/api"
@@ -23,10 +25,13 @@ import (
 	"github.com/mellis/ynab.go/api/payee"
 	"github.com/mellis/ynab.go/api/transaction"
 	"github.com/mellis/ynab.go/api/user"
+	"github.com/mellis/ynab.go/oauth"
 )
 
 const apiEndpoint = "https://api.youneedabudget.com/v1"
 
+var errInvalidBaseURL = errors.New("ynab: invalid base URL")
+
 // ClientServicer contract for a client service API
 type ClientServicer interface {
 	User() *user.Service
@@ -44,6 +49,7 @@ type ClientServicer interface {
 func NewClient(accessToken string, options ...func(*client)) ClientServicer {
 	c := &client{
 		accessToken: accessToken,
+		baseURL:     apiEndpoint,
 		client:      http.DefaultClient,
 	}
 	for _, o := range options {
@@ -57,6 +63,11 @@ func NewClient(accessToken string, options ...func(*client)) ClientServicer {
 	c.payee = payee.NewService(c)
 	c.month = month.NewService(c)
 	c.transaction = transaction.NewService(c)
+
+	c.handler = c.roundTrip
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		c.handler = c.interceptors[i](c.handler)
+	}
 	return c
 }
 
@@ -65,9 +76,23 @@ type client struct {
 	sync.Mutex
 
 	accessToken string
+	tokenSource oauth.TokenSource
+	baseURL     string
+	// err holds a configuration error set by an option, returned
+	// for every request sent by the client
+	err error
 
 	client    *http.Client
+	retry     *RetryPolicy
+	limiter   *rateLimiter
 	rateLimit *api.RateLimit
+	// stats counts the requests of clients created by a Pool
+	stats *clientStats
+
+	// interceptors the middlewares installed, handler the resulting
+	// chain ending with roundTrip
+	interceptors []Interceptor
+	handler      Handler
 
 	user        *user.Service
 	budget      *budget.Service
@@ -114,8 +139,14 @@ func (c *client) Transaction() *transaction.Service {
 }
 
 // RateLimit returns the last rate limit information returned
-// from the YNAB API
+// from the YNAB API, or the client-side rate limiter state when enabled
 func (c *client) RateLimit() *api.RateLimit {
+	if c.limiter != nil {
+		return c.limiter.snapshot()
+	}
+
+	c.Lock()
+	defer c.Unlock()
 	return c.rateLimit
 }
 
@@ -139,60 +170,175 @@ func (c *client) Patch(ctx context.Context, url string, responseModel interface{
 	return c.do(ctx, http.MethodPatch, url, responseModel, requestBody)
 }
 
-// do sends a request to the YNAB API
-func (c *client) do(ctx context.Context, method, url string, responseModel interface{}, requestBody []byte) error {
-	fullURL := fmt.Sprintf("%s%s", apiEndpoint, url)
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return err
-	}
+// Delete sends a Delete request to the YNAB API
+func (c *client) Delete(ctx context.Context, url string, responseModel interface{}) error {
+	return c.do(ctx, http.MethodDelete, url, responseModel, nil)
+}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.accessToken))
-	if method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch {
-		req.Header.Set("Content-Type", "application/json")
+// do sends a request to the YNAB API through the middleware chain and
+// decodes the response
+func (c *client) do(ctx context.Context, method, url string, responseModel interface{}, requestBody []byte) (err error) {
+	if c.stats != nil {
+		defer func() { c.stats.record(err) }()
+	}
+	if c.err != nil {
+		return c.err
 	}
 
-	res, err := c.client.Do(req)
+	res, err := c.handler(ctx, newRequest(method, url, requestBody))
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
+	if res.Err != nil {
+		return res.Err
+	}
 
-	body, err := io.ReadAll(res.Body)
+	c.Lock()
+	c.rateLimit = res.RateLimit
+	c.Unlock()
+
+	return json.Unmarshal(res.Body, &responseModel)
+}
+
+// roundTrip is the innermost handler of the middleware chain. It sends
+// the request, retrying it according to the client retry policy and
+// refreshing the access token once when rejected
+func (c *client) roundTrip(ctx context.Context, req *Request) (*Response, error) {
+	accessToken := c.accessToken
+	var token *oauth.Token
+	if c.tokenSource != nil {
+		t, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ynab: obtaining access token: %w", err)
+		}
+		token, accessToken = t, t.AccessToken
+	}
+
+	res, body, err := c.sendWithRetry(ctx, req, accessToken)
+	if err == nil && res.StatusCode == http.StatusUnauthorized && c.tokenSource != nil {
+		// the token may have been revoked or expired early, refresh it
+		// and retry once
+		t, refreshErr := c.tokenSource.Refresh(ctx, token)
+		if refreshErr != nil {
+			return nil, fmt.Errorf("ynab: refreshing access token: %w", refreshErr)
+		}
+		res, body, err = c.sendWithRetry(ctx, req, t.AccessToken)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	response := &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}
 	if res.StatusCode >= 400 {
-		response := struct {
-			Error *api.Error `json:"error"`
-		}{}
-
-		if err := json.Unmarshal(body, &response); err != nil {
-			// returns a forged *api.Error fore ease of use
-			// because either the response body is empty or the response is
-			// non compliant with YNAB's API specification
-			// https://api.youneedabudget.com/#errors
-			apiError := &api.Error{
-				ID:     strconv.Itoa(res.StatusCode),
-				Name:   "unknown_api_error",
-				Detail: "Unknown API error",
+		response.Err = newAPIError(req.Method, req.Path, res, body)
+		response.RateLimit = response.Err.RateLimit
+		return response, nil
+	}
+
+	if response.RateLimit, err = api.ParseRateLimit(res.Header.Get("X-Rate-Limit")); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// sendWithRetry sends a request to the YNAB API, retrying it according
+// to the client retry policy
+func (c *client) sendWithRetry(ctx context.Context, req *Request, accessToken string) (*http.Response, []byte, error) {
+	var (
+		res  *http.Response
+		body []byte
+		err  error
+	)
+	for attempt := 1; ; attempt++ {
+		res, body, err = c.send(ctx, req, accessToken)
+		if !c.retry.allows(ctx, req.Method, attempt, res, err) {
+			break
+		}
+		if waitErr := c.retry.wait(ctx, attempt, res); waitErr != nil {
+			if errors.Is(waitErr, errRetryDeadline) {
+				break
 			}
-			return apiError
+			return nil, nil, waitErr
+		}
+	}
+	return res, body, err
+}
+
+// newAPIError builds the *api.Error for a failed request
+func newAPIError(method, url string, res *http.Response, body []byte) *api.Error {
+	response := struct {
+		Error *api.Error `json:"error"`
+	}{}
+
+	var apiError *api.Error
+	if err := json.Unmarshal(body, &response); err == nil {
+		apiError = response.Error
+	}
+	if apiError == nil {
+		// returns a forged *api.Error fore ease of use
+		// because either the response body is empty or the response is
+		// non compliant with YNAB's API specification
+		// https://api.youneedabudget.com/#errors
+		apiError = &api.Error{
+			ID:     strconv.Itoa(res.StatusCode),
+			Name:   "unknown_api_error",
+			Detail: "Unknown API error",
 		}
+	}
+
+	apiError.StatusCode = res.StatusCode
+	apiError.Method = method
+	apiError.Path = url
+	apiError.Body = body
+	if rl, err := api.ParseRateLimit(res.Header.Get("X-Rate-Limit")); err == nil {
+		apiError.RateLimit = rl
+	}
+	return apiError
+}
 
-		return response.Error
+// send sends a single request to the YNAB API and reads the whole
+// response body
+func (c *client) send(ctx context.Context, r *Request, accessToken string) (*http.Response, []byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	rl, err := api.ParseRateLimit(res.Header.Get("X-Rate-Limit"))
+	fullURL := fmt.Sprintf("%s/%s", c.baseURL, strings.TrimLeft(r.Path, "/"))
+	req, err := http.NewRequestWithContext(ctx, r.Method, fullURL, bytes.NewReader(r.Body))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	c.Lock()
-	c.rateLimit = rl
-	c.Unlock()
+	req.Header.Set("Accept", "application/json")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if c.limiter != nil {
+		if rl, err := api.ParseRateLimit(res.Header.Get("X-Rate-Limit")); err == nil {
+			c.limiter.observe(rl)
+		}
+	}
 
-	return json.Unmarshal(body, &responseModel)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, body, nil
 }
@@ -1,9 +1,63 @@
 package ynab
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mellis/ynab.go/oauth"
+)
 
 func HTTPClient(hc *http.Client) func(*client) {
 	return func(c *client) {
 		c.client = hc
 	}
 }
+
+// BaseURL sets the URL every API request is resolved against, e.g.
+// "https://api.ynab.com/v1" or the address of a local YNAB stand-in.
+// The URL must be absolute with an http or https scheme; trailing slashes
+// are ignored. An invalid URL makes every request fail with an error.
+func BaseURL(baseURL string) func(*client) {
+	return func(c *client) {
+		u, err := parseBaseURL(baseURL)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.baseURL = u
+	}
+}
+
+// parseBaseURL validates and normalises a base URL so it can be joined
+// with the API paths
+func parseBaseURL(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %s", errInvalidBaseURL, baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("%w %q: scheme must be http or https", errInvalidBaseURL, baseURL)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%w %q: missing host", errInvalidBaseURL, baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("%w %q: query and fragment are not allowed", errInvalidBaseURL, baseURL)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// TokenSource authorizes requests with the tokens of an OAuth token
+// source instead of the access token given to NewClient. Expired tokens
+// are refreshed before sending requests, and a request rejected with a
+// 401 Unauthorized is retried once with a refreshed token
+func TokenSource(ts oauth.TokenSource) func(*client) {
+	return func(c *client) {
+		c.tokenSource = ts
+	}
+}
@@ -6,13 +6,18 @@ package ynab
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/oauth"
 )
 
 func TestClient_GET(t *testing.T) {
@@ -494,3 +499,283 @@ func TestClient_PATCH(t *testing.T) {
 		}{}, response)
 	})
 }
+
+func TestClient_DELETE(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodDelete, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "application/json", req.Header.Get("Accept"))
+				assert.Equal(t, "Bearer 6zL9vh8]B9H3BEecwL%Vzh^VwKR3C2CNZ3Bv%=fFxm$z)duY[U+2=3CydZrkQFnA", req.Header.Get("Authorization"))
+				assert.Equal(t, "", req.Header.Get("Content-Type"))
+
+				res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("6zL9vh8]B9H3BEecwL%Vzh^VwKR3C2CNZ3Bv%=fFxm$z)duY[U+2=3CydZrkQFnA")
+		err := c.(*client).Delete(context.Background(), "/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+	})
+
+	t.Run("failure with with expected API error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodDelete, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusNotFound, `{
+  "error": {
+    "id": "404.2",
+    "name": "resource_not_found",
+    "detail": "Resource not found"
+  }
+}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		c := NewClient("")
+		err := c.(*client).Delete(context.Background(), "/foo", nil)
+		expectedErrStr := "api: error id=404.2 name=resource_not_found detail=Resource not found"
+		assert.EqualError(t, err, expectedErrStr)
+	})
+}
+
+func TestBaseURL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		table := []struct {
+			BaseURL string
+			Path    string
+			Out     string
+		}{
+			{"https://api.ynab.com/v1", "/budgets", "https://api.ynab.com/v1/budgets"},
+			{"https://api.ynab.com/v1/", "/budgets", "https://api.ynab.com/v1/budgets"},
+			{"https://api.ynab.com/v1//", "budgets", "https://api.ynab.com/v1/budgets"},
+			{"http://localhost:8080", "/budgets?last_knowledge_of_server=1", "http://localhost:8080/budgets?last_knowledge_of_server=1"},
+			{"http://127.0.0.1:8080/", "/user", "http://127.0.0.1:8080/user"},
+		}
+
+		for _, test := range table {
+			httpmock.Activate()
+
+			httpmock.RegisterResponder(http.MethodGet, test.Out,
+				func(req *http.Request) (*http.Response, error) {
+					res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+					res.Header.Add("X-Rate-Limit", "36/200")
+					return res, nil
+				},
+			)
+
+			response := struct {
+				Foo string `json:"foo"`
+			}{}
+
+			c := NewClient("", BaseURL(test.BaseURL))
+			err := c.(*client).Get(context.Background(), test.Path, &response)
+			assert.NoError(t, err, test.BaseURL)
+			assert.Equal(t, "bar", response.Foo, test.BaseURL)
+
+			httpmock.DeactivateAndReset()
+		}
+	})
+
+	t.Run("failure with invalid base URL", func(t *testing.T) {
+		table := []string{
+			"api.ynab.com/v1",
+			"ftp://api.ynab.com/v1",
+			"https:///v1",
+			"https://api.ynab.com/v1?foo=bar",
+			"https://api.ynab.com/v1#foo",
+			"://api.ynab.com",
+		}
+
+		for _, baseURL := range table {
+			c := NewClient("", BaseURL(baseURL))
+			err := c.(*client).Get(context.Background(), "/foo", nil)
+			assert.True(t, errors.Is(err, errInvalidBaseURL), baseURL)
+		}
+	})
+}
+
+func TestClient_Error(t *testing.T) {
+	t.Run("expected API error carries the request and response details", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		body := `{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/budgets/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusNotFound, body)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		c := NewClient("")
+		err := c.(*client).Get(context.Background(), "/budgets/foo", nil)
+		assert.True(t, errors.Is(err, api.ErrNotFound))
+		assert.False(t, errors.Is(err, api.ErrBadRequest))
+
+		var apiErr *api.Error
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "404.2", apiErr.ID)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, http.MethodGet, apiErr.Method)
+		assert.Equal(t, "/budgets/foo", apiErr.Path)
+		assert.Equal(t, body, string(apiErr.Body))
+		assert.Equal(t, uint64(36), apiErr.RateLimit.Used())
+	})
+
+	t.Run("unexpected API error is forged from the status code", func(t *testing.T) {
+		for _, body := range []string{"", "Too Many Requests", "{}", `{"error":null}`} {
+			httpmock.Activate()
+
+			httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+				func(req *http.Request) (*http.Response, error) {
+					return httpmock.NewStringResponse(http.StatusTooManyRequests, body), nil
+				},
+			)
+
+			c := NewClient("")
+			err := c.(*client).Post(context.Background(), "/foo", nil, nil)
+			assert.EqualError(t, err, "api: error id=429 name=unknown_api_error detail=Unknown API error", body)
+			assert.True(t, errors.Is(err, api.ErrRateLimited), body)
+
+			var apiErr *api.Error
+			assert.True(t, errors.As(err, &apiErr), body)
+			assert.Equal(t, body, string(apiErr.Body))
+			assert.Nil(t, apiErr.RateLimit)
+
+			httpmock.DeactivateAndReset()
+		}
+	})
+}
+
+func TestTokenSource(t *testing.T) {
+	conf := &oauth.Config{ClientID: "id", ClientSecret: "secret"}
+
+	registerFoo := func(calls *[]string) {
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				auth := req.Header.Get("Authorization")
+				*calls = append(*calls, auth)
+				if auth != "Bearer fresh" {
+					res := httpmock.NewStringResponse(http.StatusUnauthorized,
+						`{"error":{"id":"401","name":"unauthorized","detail":"Unauthorized"}}`)
+					res.Header.Add("X-Rate-Limit", "36/200")
+					return res, nil
+				}
+				res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+	}
+	registerToken := func(refreshes *int) {
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			func(req *http.Request) (*http.Response, error) {
+				*refreshes++
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+				assert.Equal(t, "r1", req.PostForm.Get("refresh_token"))
+				return httpmock.NewStringResponse(http.StatusOK,
+					`{"access_token":"fresh","token_type":"bearer","expires_in":7200,"refresh_token":"r2"}`), nil
+			},
+		)
+	}
+
+	t.Run("refreshes an expired token before sending", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls []string
+		var refreshes int
+		registerFoo(&calls)
+		registerToken(&refreshes)
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{
+			AccessToken:  "stale",
+			RefreshToken: "r1",
+			Expiry:       time.Now().Add(-time.Minute),
+		})
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+		c := NewClient("", TokenSource(ts))
+		err := c.(*client).Get(context.Background(), "/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, []string{"Bearer fresh"}, calls)
+		assert.Equal(t, 1, refreshes)
+	})
+
+	t.Run("retries once on 401 with a refreshed token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls []string
+		var refreshes int
+		registerFoo(&calls)
+		registerToken(&refreshes)
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{
+			AccessToken:  "revoked",
+			RefreshToken: "r1",
+			Expiry:       time.Now().Add(time.Hour),
+		})
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+		c := NewClient("", TokenSource(ts))
+		err := c.(*client).Get(context.Background(), "/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, []string{"Bearer revoked", "Bearer fresh"}, calls)
+		assert.Equal(t, 1, refreshes)
+	})
+
+	t.Run("does not retry twice", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls []string
+		registerFoo(&calls)
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusOK,
+				`{"access_token":"also-revoked","expires_in":7200,"refresh_token":"r2"}`))
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "revoked", RefreshToken: "r1"})
+		err := NewClient("", TokenSource(ts)).(*client).Get(context.Background(), "/foo", nil)
+		assert.True(t, errors.Is(err, api.ErrUnauthorized))
+		assert.Equal(t, []string{"Bearer revoked", "Bearer also-revoked"}, calls)
+	})
+
+	t.Run("refresh failure", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls []string
+		registerFoo(&calls)
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusBadRequest,
+				`{"error":"invalid_grant","error_description":"The refresh token is invalid"}`))
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "revoked", RefreshToken: "r1"})
+		err := NewClient("", TokenSource(ts)).(*client).Get(context.Background(), "/foo", nil)
+		var oauthErr *oauth.Error
+		assert.True(t, errors.As(err, &oauthErr))
+		assert.Equal(t, "invalid_grant", oauthErr.Code)
+		assert.Len(t, calls, 1)
+	})
+}
@@ -7,7 +7,11 @@ package ynab_test
 import (
 	"context"
 	"fmt"
+	"log"
+	"log/slog"
+	"os"
 	"reflect"
+	"time"
 
 	"github.com/mellis/ynab.go"
 )
@@ -17,6 +21,35 @@ func ExampleNewClient() {
 	c.User().GetUser(context.Background()) //nolint:errcheck
 }
 
+func ExampleBaseURL() {
+	c := ynab.NewClient("<valid_ynab_access_token>", ynab.BaseURL("https://api.ynab.com/v1"))
+	c.User().GetUser(context.Background()) //nolint:errcheck
+}
+
+func ExampleMiddleware() {
+	requestID := func(next ynab.Handler) ynab.Handler {
+		return func(ctx context.Context, req *ynab.Request) (*ynab.Response, error) {
+			req.Header.Set("X-Request-Id", "<request_id>")
+			return next(ctx, req)
+		}
+	}
+
+	c := ynab.NewClient("<valid_ynab_access_token>", ynab.Middleware(
+		ynab.Logging(log.Default()),
+		ynab.Timing(func(req *ynab.Request, res *ynab.Response, err error, d time.Duration) {
+			fmt.Println(req.Method, req.BudgetID, d)
+		}),
+		requestID,
+	))
+	c.User().GetUser(context.Background()) //nolint:errcheck
+}
+
+func ExampleSlog() {
+	l := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := ynab.NewClient("<valid_ynab_access_token>", ynab.Slog(l, true))
+	c.User().GetUser(context.Background()) //nolint:errcheck
+}
+
 func ExampleClientServicer_User() {
 	c := ynab.NewClient("<valid_ynab_access_token>")
 	s := c.User()
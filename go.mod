@@ -1,6 +1,6 @@
 module github.com/mellis/ynab.go
 
-go 1.19
+go 1.21
 
 require (
 	github.com/stretchr/testify v1.2.2
@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package apitest implements the helpers shared by the tests of the API
+// packages
+package apitest // import "github.com/mellis/ynab.go/internal/apitest"
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// AssertRoundTrip asserts the fixture is encoded back unchanged once
+// decoded into v
+func AssertRoundTrip(t *testing.T, fixture string, v interface{}) bool {
+	if !assert.NoError(t, json.Unmarshal([]byte(fixture), v)) {
+		return false
+	}
+	buf, err := json.Marshal(v)
+	if !assert.NoError(t, err) {
+		return false
+	}
+	return assert.JSONEq(t, fixture, string(buf))
+}
+
+// AssertDataRoundTrip asserts the entity under key in the data of an API
+// response is encoded back unchanged once decoded into v
+func AssertDataRoundTrip(t *testing.T, response, key string, v interface{}) bool {
+	var payload struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if !assert.NoError(t, json.Unmarshal([]byte(response), &payload)) {
+		return false
+	}
+	raw, ok := payload.Data[key]
+	if !assert.True(t, ok, "data has no %q", key) {
+		return false
+	}
+	return AssertRoundTrip(t, string(raw), v)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+// pathParams maps the API collections to the name of the parameter
+// following them in path templates
+var pathParams = map[string]string{
+	"budgets":                "{budget_id}",
+	"accounts":               "{account_id}",
+	"categories":             "{category_id}",
+	"category_groups":        "{category_group_id}",
+	"months":                 "{month}",
+	"payees":                 "{payee_id}",
+	"payee_locations":        "{payee_location_id}",
+	"transactions":           "{transaction_id}",
+	"scheduled_transactions": "{scheduled_transaction_id}",
+}
+
+// pathActions the path segments following a collection which are not IDs
+var pathActions = map[string]bool{
+	"bulk":   true,
+	"import": true,
+}
+
+// operations maps the endpoints to the service methods calling them
+var operations = map[string]string{
+	"GET /user": "user.GetUser",
+
+	"GET /budgets":                      "budget.GetBudgets",
+	"GET /budgets/{budget_id}":          "budget.GetBudget",
+	"GET /budgets/{budget_id}/settings": "budget.GetBudgetSettings",
+
+	"GET /budgets/{budget_id}/accounts":              "account.GetAccounts",
+	"GET /budgets/{budget_id}/accounts/{account_id}": "account.GetAccount",
+	"POST /budgets/{budget_id}/accounts":             "account.CreateAccount",
+
+	"GET /budgets/{budget_id}/categories":                              "category.GetCategories",
+	"POST /budgets/{budget_id}/categories":                             "category.CreateCategory",
+	"GET /budgets/{budget_id}/categories/{category_id}":                "category.GetCategory",
+	"PATCH /budgets/{budget_id}/categories/{category_id}":              "category.UpdateCategory",
+	"GET /budgets/{budget_id}/months/{month}/categories/{category_id}": "category.GetCategoryForMonth",
+	"PUT /budgets/{budget_id}/months/{month}/categories/{category_id}": "category.UpdateCategoryForMonth",
+	"POST /budgets/{budget_id}/category_groups":                        "category.CreateCategoryGroup",
+	"PATCH /budgets/{budget_id}/category_groups/{category_group_id}":   "category.UpdateCategoryGroup",
+
+	"GET /budgets/{budget_id}/months":         "month.GetMonths",
+	"GET /budgets/{budget_id}/months/{month}": "month.GetMonth",
+
+	"GET /budgets/{budget_id}/payees":                              "payee.GetPayees",
+	"GET /budgets/{budget_id}/payees/{payee_id}":                   "payee.GetPayee",
+	"PATCH /budgets/{budget_id}/payees/{payee_id}":                 "payee.UpdatePayee",
+	"GET /budgets/{budget_id}/payee_locations":                     "payee.GetPayeeLocations",
+	"GET /budgets/{budget_id}/payee_locations/{payee_location_id}": "payee.GetPayeeLocation",
+	"GET /budgets/{budget_id}/payees/{payee_id}/payee_locations":   "payee.GetPayeeLocationsByPayee",
+
+	"GET /budgets/{budget_id}/transactions":                                         "transaction.GetTransactions",
+	"POST /budgets/{budget_id}/transactions":                                        "transaction.CreateTransactions",
+	"PATCH /budgets/{budget_id}/transactions":                                       "transaction.UpdateTransactions",
+	"POST /budgets/{budget_id}/transactions/bulk":                                   "transaction.BulkCreateTransactions",
+	"GET /budgets/{budget_id}/transactions/{transaction_id}":                        "transaction.GetTransaction",
+	"PUT /budgets/{budget_id}/transactions/{transaction_id}":                        "transaction.UpdateTransaction",
+	"DELETE /budgets/{budget_id}/transactions/{transaction_id}":                     "transaction.DeleteTransaction",
+	"GET /budgets/{budget_id}/accounts/{account_id}/transactions":                   "transaction.GetTransactionsByAccount",
+	"GET /budgets/{budget_id}/categories/{category_id}/transactions":                "transaction.GetTransactionsByCategory",
+	"GET /budgets/{budget_id}/payees/{payee_id}/transactions":                       "transaction.GetTransactionsByPayee",
+	"GET /budgets/{budget_id}/scheduled_transactions":                               "transaction.GetScheduledTransactions",
+	"POST /budgets/{budget_id}/scheduled_transactions":                              "transaction.CreateScheduledTransaction",
+	"GET /budgets/{budget_id}/scheduled_transactions/{scheduled_transaction_id}":    "transaction.GetScheduledTransaction",
+	"PUT /budgets/{budget_id}/scheduled_transactions/{scheduled_transaction_id}":    "transaction.UpdateScheduledTransaction",
+	"DELETE /budgets/{budget_id}/scheduled_transactions/{scheduled_transaction_id}": "transaction.DeleteScheduledTransaction",
+}
+
+// Request a request to the YNAB API, as seen by the middlewares
+type Request struct {
+	// Method the HTTP method, e.g. http.MethodGet
+	Method string
+	// Path the API path, relative to the base URL and including the query,
+	// e.g. "/budgets/last-used/transactions?since_date=2018-01-01"
+	Path string
+	// PathTemplate the API path with its IDs replaced by the name of their
+	// parameter, e.g. "/budgets/{budget_id}/transactions"
+	PathTemplate string
+	// Operation the service method sending the request, e.g.
+	// "transaction.GetTransactions", empty for unknown endpoints
+	Operation string
+	// BudgetID the budget the request is about, empty for requests which
+	// are not scoped to a budget
+	BudgetID string
+	// Body the JSON request body, nil for requests without body
+	Body []byte
+	// Header the headers added to the HTTP request. They take precedence
+	// over the headers set by the client, including Authorization
+	Header http.Header
+}
+
+// Response a response from the YNAB API, as seen by the middlewares
+type Response struct {
+	// StatusCode the HTTP status code
+	StatusCode int
+	// Header the HTTP response headers
+	Header http.Header
+	// Body the JSON response body
+	Body []byte
+	// RateLimit the rate limit state reported by the API, nil when the
+	// response did not report it
+	RateLimit *api.RateLimit
+	// Err the decoded API error for responses with a 4xx or 5xx status
+	Err *api.Error
+}
+
+// Handler sends a request to the YNAB API. The error is only set when no
+// response was received, API errors are reported by Response.Err
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Interceptor wraps a Handler, e.g. to log, measure or decorate requests.
+// It calls next to send the request
+type Interceptor func(next Handler) Handler
+
+// Middleware installs interceptors around the requests sent by the client.
+// The first interceptor is the outermost one. Interceptors see each request
+// once, retries and access token refreshes happen within the chain
+func Middleware(interceptors ...Interceptor) func(*client) {
+	return func(c *client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// Logger contract for the logger used by the Logging middleware,
+// satisfied by *log.Logger
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Logging logs every request with its outcome and duration, e.g.
+// "ynab: GET /budgets/last-used/accounts 200 in 120ms (37/200)"
+func Logging(l Logger) Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			d := time.Since(start).Round(time.Millisecond)
+
+			switch {
+			case err != nil:
+				l.Printf("ynab: %s %s failed in %s: %s", req.Method, req.Path, d, err)
+			case res.Err != nil:
+				l.Printf("ynab: %s %s %d in %s: %s", req.Method, req.Path, res.StatusCode, d, res.Err)
+			case res.RateLimit != nil:
+				l.Printf("ynab: %s %s %d in %s (%d/%d)", req.Method, req.Path, res.StatusCode, d,
+					res.RateLimit.Used(), res.RateLimit.Total())
+			default:
+				l.Printf("ynab: %s %s %d in %s", req.Method, req.Path, res.StatusCode, d)
+			}
+			return res, err
+		}
+	}
+}
+
+// Timing reports the duration of every request to observe, e.g. to feed
+// a metrics histogram. res is nil when err is set
+func Timing(observe func(req *Request, res *Response, err error, d time.Duration)) Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			observe(req, res, err, time.Since(start))
+			return res, err
+		}
+	}
+}
+
+// newRequest builds the request passed down the middleware chain
+func newRequest(method, path string, body []byte) *Request {
+	template := pathTemplate(path)
+	return &Request{
+		Method:       method,
+		Path:         path,
+		PathTemplate: template,
+		Operation:    operations[method+" "+template],
+		BudgetID:     budgetID(path),
+		Body:         body,
+		Header:       make(http.Header),
+	}
+}
+
+// pathTemplate replaces the IDs of an API path with the name of their
+// parameter and drops the query, e.g. "/budgets/{budget_id}/transactions"
+func pathTemplate(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		param, ok := pathParams[segments[i-1]]
+		if ok && !pathActions[segments[i]] {
+			segments[i] = param
+			i++
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// budgetID extracts the budget ID from an API path such as
+// "/budgets/{budget_id}/accounts"
+func budgetID(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "budgets" {
+		return ""
+	}
+	return segments[1]
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Run("chain order and typed request", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		url := fmt.Sprintf("%s%s", apiEndpoint, "/budgets/aa248caa/accounts")
+		httpmock.RegisterResponder(http.MethodPost, url,
+			func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "Bearer decorated", req.Header.Get("Authorization"))
+				assert.Equal(t, "abc", req.Header.Get("X-Request-Id"))
+				res := httpmock.NewStringResponse(http.StatusCreated, `{"foo":"bar"}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		var calls []string
+		trace := func(name string) Interceptor {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Response, error) {
+					calls = append(calls, name+" before")
+					res, err := next(ctx, req)
+					calls = append(calls, name+" after")
+					return res, err
+				}
+			}
+		}
+		decorate := func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/budgets/aa248caa/accounts", req.Path)
+				assert.Equal(t, "/budgets/{budget_id}/accounts", req.PathTemplate)
+				assert.Equal(t, "account.CreateAccount", req.Operation)
+				assert.Equal(t, "aa248caa", req.BudgetID)
+				assert.Equal(t, `{"account":{}}`, string(req.Body))
+				req.Header.Set("Authorization", "Bearer decorated")
+				req.Header.Set("X-Request-Id", "abc")
+
+				res, err := next(ctx, req)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, res.StatusCode)
+				assert.Equal(t, uint64(36), res.RateLimit.Used())
+				assert.Nil(t, res.Err)
+				return res, err
+			}
+		}
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+		c := NewClient("token", Middleware(trace("outer"), trace("inner")), Middleware(decorate))
+		err := c.(*client).Post(context.Background(), "/budgets/aa248caa/accounts", &response, []byte(`{"account":{}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, calls)
+		assert.Equal(t, uint64(36), c.RateLimit().Used())
+	})
+
+	t.Run("decoded API error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusNotFound,
+					`{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		var seen *Response
+		c := NewClient("token", Middleware(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				res, err := next(ctx, req)
+				seen = res
+				return res, err
+			}
+		}))
+		err := c.(*client).Get(context.Background(), "/foo", nil)
+		assert.True(t, errors.Is(err, api.ErrNotFound))
+		assert.Equal(t, http.StatusNotFound, seen.StatusCode)
+		assert.Equal(t, "resource_not_found", seen.Err.Name)
+		assert.Equal(t, uint64(36), seen.RateLimit.Used())
+	})
+
+	t.Run("retries happen within the chain", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodGet, http.StatusServiceUnavailable)
+
+		var seen int
+		c := NewClient("token", Retry(testRetryPolicy()), Middleware(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				seen++
+				return next(ctx, req)
+			}
+		}))
+		assert.NoError(t, c.(*client).Get(context.Background(), "/foo", nil))
+		assert.Equal(t, 2, *calls)
+		assert.Equal(t, 1, seen)
+	})
+
+	t.Run("short circuit", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+		c := NewClient("token", Middleware(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				return &Response{StatusCode: http.StatusOK, Body: []byte(`{"foo":"cached"}`)}, nil
+			}
+		}))
+		assert.NoError(t, c.(*client).Get(context.Background(), "/foo", &response))
+		assert.Equal(t, "cached", response.Foo)
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestLogging(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/budgets/last-used/accounts"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+			res.Header.Add("X-Rate-Limit", "37/200")
+			return res, nil
+		},
+	)
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/budgets/unknown"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusNotFound,
+				`{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`)
+			res.Header.Add("X-Rate-Limit", "38/200")
+			return res, nil
+		},
+	)
+
+	var buf bytes.Buffer
+	c := NewClient("token", Middleware(Logging(log.New(&buf, "", 0))))
+	assert.NoError(t, c.(*client).Get(context.Background(), "/budgets/last-used/accounts", nil))
+	assert.Error(t, c.(*client).Get(context.Background(), "/budgets/unknown", nil))
+	assert.Error(t, c.(*client).Get(context.Background(), "/budgets", nil))
+
+	assert.Regexp(t, `^ynab: GET /budgets/last-used/accounts 200 in \d+m?s \(37/200\)
+ynab: GET /budgets/unknown 404 in \d+m?s: api: error id=404.2 name=resource_not_found detail=Resource not found
+ynab: GET /budgets failed in \d+m?s: .+
+$`, buf.String())
+}
+
+func TestTiming(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			time.Sleep(5 * time.Millisecond)
+			res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+
+	var observed []time.Duration
+	c := NewClient("token", Middleware(Timing(func(req *Request, res *Response, err error, d time.Duration) {
+		assert.Equal(t, "/foo", req.Path)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		observed = append(observed, d)
+	})))
+	assert.NoError(t, c.(*client).Get(context.Background(), "/foo", nil))
+	assert.Len(t, observed, 1)
+	assert.True(t, observed[0] >= 5*time.Millisecond)
+}
+
+func TestBudgetID(t *testing.T) {
+	table := []struct {
+		path     string
+		budgetID string
+	}{
+		{path: "/user"},
+		{path: "/budgets"},
+		{path: "/budgets/"},
+		{path: "/budgets?include_accounts=true"},
+		{path: "/budgets/last-used", budgetID: "last-used"},
+		{path: "/budgets/aa248caa/accounts", budgetID: "aa248caa"},
+		{path: "budgets/aa248caa", budgetID: "aa248caa"},
+		{path: "/budgets/aa248caa?last_knowledge_of_server=2", budgetID: "aa248caa"},
+	}
+
+	for _, test := range table {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.budgetID, budgetID(test.path))
+		})
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	table := []struct {
+		path     string
+		template string
+	}{
+		{path: "/user", template: "/user"},
+		{path: "/budgets", template: "/budgets"},
+		{path: "/budgets/last-used", template: "/budgets/{budget_id}"},
+		{path: "/budgets/aa248caa/settings", template: "/budgets/{budget_id}/settings"},
+		{path: "/budgets/aa248caa/accounts/09eaca5e", template: "/budgets/{budget_id}/accounts/{account_id}"},
+		{path: "/budgets/aa248caa/transactions?since_date=2018-01-01", template: "/budgets/{budget_id}/transactions"},
+		{path: "/budgets/aa248caa/transactions/import", template: "/budgets/{budget_id}/transactions/import"},
+		{path: "/budgets/aa248caa/transactions/bulk", template: "/budgets/{budget_id}/transactions/bulk"},
+		{
+			path:     "/budgets/aa248caa/months/2018-01-01/categories/13419c12",
+			template: "/budgets/{budget_id}/months/{month}/categories/{category_id}",
+		},
+		{
+			path:     "/budgets/aa248caa/payees/793a7a7e/transactions",
+			template: "/budgets/{budget_id}/payees/{payee_id}/transactions",
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.template, pathTemplate(test.path))
+		})
+	}
+}
+
+func TestOperations(t *testing.T) {
+	for endpoint, operation := range operations {
+		method, template, _ := strings.Cut(endpoint, " ")
+		assert.Equal(t, template, pathTemplate(template), endpoint)
+		assert.Equal(t, operation, newRequest(method, template, nil).Operation, endpoint)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package oauth implements the OAuth 2.0 flows of the YNAB API, so
+// applications can act on behalf of YNAB users instead of using a
+// personal access token
+// https://api.youneedabudget.com/#oauth-applications
+package oauth // import "github.com/mellis/ynab.go/oauth"
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthURL the YNAB authorization endpoint
+	AuthURL = "https://app.youneedabudget.com/oauth/authorize"
+	// TokenURL the YNAB token endpoint
+	TokenURL = "https://app.youneedabudget.com/oauth/token"
+
+	// ScopeReadOnly restricts the access granted to the application to
+	// read requests
+	ScopeReadOnly = "read-only"
+)
+
+var (
+	errInvalidState    = errors.New("oauth: invalid state")
+	errInvalidRedirect = errors.New("oauth: invalid redirect")
+	errNoRefreshToken  = errors.New("oauth: no refresh token")
+	errInvalidResponse = errors.New("oauth: invalid token response")
+)
+
+// Config describes an OAuth application registered with YNAB
+type Config struct {
+	// ClientID the application client ID
+	ClientID string
+	// ClientSecret the application client secret. It is not needed for
+	// the implicit grant
+	ClientSecret string
+	// RedirectURL the URL YNAB redirects users to after authorization. It
+	// must match one of the redirect URIs of the application
+	RedirectURL string
+	// Scopes the scopes requested, e.g. ScopeReadOnly. Empty requests a
+	// full access
+	Scopes []string
+
+	// AuthURL overrides the authorization endpoint, defaults to AuthURL
+	AuthURL string
+	// TokenURL overrides the token endpoint, defaults to TokenURL
+	TokenURL string
+	// HTTPClient the client sending token requests, defaults to
+	// http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// Error is returned when the token endpoint rejects a request
+type Error struct {
+	// StatusCode the HTTP status code of the response
+	StatusCode int
+	// Code the OAuth error code, e.g. "invalid_grant"
+	Code string `json:"error"`
+	// Description the human readable description of the error
+	Description string `json:"error_description"`
+}
+
+// Error returns the string version of the error
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("oauth: %s (status %d)", e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("oauth: %s: %s (status %d)", e.Code, e.Description, e.StatusCode)
+}
+
+// NewState returns a random state to bind an authorization request to the
+// user session, protecting the redirect endpoint against CSRF. Store it
+// with the session and check it with VerifyState on redirect
+func NewState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// VerifyState checks in constant time the state received on redirect
+// against the one stored with the user session
+func VerifyState(expected, got string) error {
+	if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(got)) != 1 {
+		return errInvalidState
+	}
+	return nil
+}
+
+// AuthCodeURL returns the URL users are sent to for authorizing the
+// application with the authorization code grant
+func (c *Config) AuthCodeURL(state string) string {
+	return c.authURL("code", state)
+}
+
+// ImplicitURL returns the URL users are sent to for authorizing the
+// application with the implicit grant, for applications which cannot
+// keep a client secret. The access token is returned in the fragment of
+// the redirect URL, see TokenFromRedirect
+func (c *Config) ImplicitURL(state string) string {
+	return c.authURL("token", state)
+}
+
+func (c *Config) authURL(responseType, state string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURL},
+		"response_type": {responseType},
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", strings.Join(c.Scopes, " "))
+	}
+
+	u := c.AuthURL
+	if u == "" {
+		u = AuthURL
+	}
+	if strings.Contains(u, "?") {
+		return u + "&" + v.Encode()
+	}
+	return u + "?" + v.Encode()
+}
+
+// Exchange exchanges the authorization code received on redirect for a
+// token
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.RedirectURL},
+	})
+}
+
+// Refresh obtains a new token from a refresh token. YNAB rotates refresh
+// tokens, so the returned token replaces the previous one, whose refresh
+// token can no longer be used
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errNoRefreshToken
+	}
+
+	t, err := c.retrieveToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = refreshToken
+	}
+	return t, nil
+}
+
+// TokenFromRedirect reads the token of an implicit grant from the URL the
+// user was redirected to, checking its state against the expected one
+func TokenFromRedirect(redirectURL, state string) (*Token, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidRedirect, err)
+	}
+	v, err := url.ParseQuery(u.Fragment)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidRedirect, err)
+	}
+
+	if code := v.Get("error"); code != "" {
+		return nil, &Error{Code: code, Description: v.Get("error_description")}
+	}
+	if err := VerifyState(state, v.Get("state")); err != nil {
+		return nil, err
+	}
+
+	r := tokenResponse{
+		AccessToken: v.Get("access_token"),
+		TokenType:   v.Get("token_type"),
+	}
+	if r.AccessToken == "" {
+		return nil, fmt.Errorf("%w: missing access token", errInvalidRedirect)
+	}
+	if e := v.Get("expires_in"); e != "" {
+		if _, err := fmt.Sscanf(e, "%d", &r.ExpiresIn); err != nil {
+			return nil, fmt.Errorf("%w: invalid expires_in %q", errInvalidRedirect, e)
+		}
+	}
+	return r.token(time.Now()), nil
+}
+
+// tokenResponse the token endpoint response body
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+func (r *tokenResponse) token(now time.Time) *Token {
+	t := &Token{
+		AccessToken:  r.AccessToken,
+		TokenType:    r.TokenType,
+		RefreshToken: r.RefreshToken,
+	}
+	if r.ExpiresIn > 0 {
+		t.Expiry = now.Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return t
+}
+
+// retrieveToken sends a request to the token endpoint
+func (c *Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	u := c.TokenURL
+	if u == "" {
+		u = TokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	now := time.Now()
+	res, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= 400 {
+		e := &Error{StatusCode: res.StatusCode}
+		if err := json.Unmarshal(body, e); err != nil || e.Code == "" {
+			e.Code = "unknown_error"
+		}
+		return nil, e
+	}
+
+	var r tokenResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidResponse, err)
+	}
+	if r.AccessToken == "" {
+		return nil, fmt.Errorf("%w: missing access token", errInvalidResponse)
+	}
+	return r.token(now), nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go/oauth"
+)
+
+var conf = &oauth.Config{
+	ClientID:     "client-id",
+	ClientSecret: "client-secret",
+	RedirectURL:  "https://example.com/callback",
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	u, err := url.Parse(conf.AuthCodeURL("xyz"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app.youneedabudget.com", u.Host)
+	assert.Equal(t, "/oauth/authorize", u.Path)
+	assert.Equal(t, url.Values{
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"state":         {"xyz"},
+	}, u.Query())
+
+	readOnly := *conf
+	readOnly.Scopes = []string{oauth.ScopeReadOnly}
+	readOnly.AuthURL = "http://localhost/authorize?foo=bar"
+	u, err = url.Parse(readOnly.ImplicitURL(""))
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", u.Host)
+	assert.Equal(t, url.Values{
+		"client_id":     {"client-id"},
+		"foo":           {"bar"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"token"},
+		"scope":         {"read-only"},
+	}, u.Query())
+}
+
+func TestState(t *testing.T) {
+	s1, err := oauth.NewState()
+	assert.NoError(t, err)
+	s2, err := oauth.NewState()
+	assert.NoError(t, err)
+	assert.Len(t, s1, 43)
+	assert.NotEqual(t, s1, s2)
+
+	assert.NoError(t, oauth.VerifyState(s1, s1))
+	assert.Error(t, oauth.VerifyState(s1, s2))
+	assert.Error(t, oauth.VerifyState(s1, ""))
+	assert.Error(t, oauth.VerifyState("", ""))
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, url.Values{
+					"client_id":     {"client-id"},
+					"client_secret": {"client-secret"},
+					"code":          {"auth-code"},
+					"grant_type":    {"authorization_code"},
+					"redirect_uri":  {"https://example.com/callback"},
+				}, req.PostForm)
+				return httpmock.NewStringResponse(http.StatusOK, `{
+  "access_token": "0cd3d1c4-1107-11e8-b642-0ed5f89f718b",
+  "token_type": "bearer",
+  "expires_in": 7200,
+  "refresh_token": "13ae9632-1107-11e8-b642-0ed5f89f718b"
+}`), nil
+			},
+		)
+
+		before := time.Now()
+		tok, err := conf.Exchange(context.Background(), "auth-code")
+		assert.NoError(t, err)
+		assert.Equal(t, "0cd3d1c4-1107-11e8-b642-0ed5f89f718b", tok.AccessToken)
+		assert.Equal(t, "bearer", tok.TokenType)
+		assert.Equal(t, "13ae9632-1107-11e8-b642-0ed5f89f718b", tok.RefreshToken)
+		assert.False(t, tok.Expiry.Before(before.Add(2*time.Hour)))
+		assert.True(t, tok.Valid())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusBadRequest,
+				`{"error":"invalid_grant","error_description":"The authorization code is invalid"}`))
+
+		tok, err := conf.Exchange(context.Background(), "auth-code")
+		assert.Nil(t, tok)
+		var oauthErr *oauth.Error
+		assert.True(t, errors.As(err, &oauthErr))
+		assert.Equal(t, http.StatusBadRequest, oauthErr.StatusCode)
+		assert.Equal(t, "invalid_grant", oauthErr.Code)
+		assert.EqualError(t, err, "oauth: invalid_grant: The authorization code is invalid (status 400)")
+	})
+
+	t.Run("failure with a non compliant response", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusBadGateway, `<html></html>`))
+
+		_, err := conf.Exchange(context.Background(), "auth-code")
+		assert.EqualError(t, err, "oauth: unknown_error (status 502)")
+	})
+
+	t.Run("failure without access token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusOK, `{"token_type":"bearer"}`))
+
+		_, err := conf.Exchange(context.Background(), "auth-code")
+		assert.EqualError(t, err, "oauth: invalid token response: missing access token")
+	})
+}
+
+func TestConfig_Refresh(t *testing.T) {
+	t.Run("rotates the refresh token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			func(req *http.Request) (*http.Response, error) {
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+				assert.Equal(t, "r1", req.PostForm.Get("refresh_token"))
+				return httpmock.NewStringResponse(http.StatusOK,
+					`{"access_token":"a2","expires_in":7200,"refresh_token":"r2"}`), nil
+			},
+		)
+
+		tok, err := conf.Refresh(context.Background(), "r1")
+		assert.NoError(t, err)
+		assert.Equal(t, "a2", tok.AccessToken)
+		assert.Equal(t, "r2", tok.RefreshToken)
+	})
+
+	t.Run("keeps the refresh token when not rotated", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+			httpmock.NewStringResponder(http.StatusOK, `{"access_token":"a2","expires_in":7200}`))
+
+		tok, err := conf.Refresh(context.Background(), "r1")
+		assert.NoError(t, err)
+		assert.Equal(t, "r1", tok.RefreshToken)
+	})
+
+	t.Run("without refresh token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		_, err := conf.Refresh(context.Background(), "")
+		assert.EqualError(t, err, "oauth: no refresh token")
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestTokenFromRedirect(t *testing.T) {
+	table := []struct {
+		name     string
+		redirect string
+		token    *oauth.Token
+		err      string
+	}{
+		{
+			name:     "success",
+			redirect: "https://example.com/callback#access_token=a1&token_type=bearer&expires_in=7200&state=xyz",
+			token:    &oauth.Token{AccessToken: "a1", TokenType: "bearer"},
+		},
+		{
+			name:     "state mismatch",
+			redirect: "https://example.com/callback#access_token=a1&state=abc",
+			err:      "oauth: invalid state",
+		},
+		{
+			name:     "access denied",
+			redirect: "https://example.com/callback#error=access_denied&state=xyz",
+			err:      "oauth: access_denied (status 0)",
+		},
+		{
+			name:     "missing access token",
+			redirect: "https://example.com/callback#state=xyz",
+			err:      "oauth: invalid redirect: missing access token",
+		},
+		{
+			name:     "invalid expires_in",
+			redirect: "https://example.com/callback#access_token=a1&expires_in=soon&state=xyz",
+			err:      `oauth: invalid redirect: invalid expires_in "soon"`,
+		},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			tok, err := oauth.TokenFromRedirect(test.redirect, "xyz")
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				assert.Nil(t, tok)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.token.AccessToken, tok.AccessToken)
+			assert.Equal(t, test.token.TokenType, tok.TokenType)
+			assert.True(t, tok.Valid())
+			assert.WithinDuration(t, time.Now().Add(2*time.Hour), tok.Expiry, time.Minute)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/oauth"
+)
+
+func ExampleConfig_AuthCodeURL() {
+	conf := &oauth.Config{
+		ClientID:     "<valid_client_id>",
+		ClientSecret: "<valid_client_secret>",
+		RedirectURL:  "https://example.com/callback",
+	}
+
+	http.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		state, err := oauth.NewState()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "state", Value: state, HttpOnly: true, Secure: true})
+		http.Redirect(w, r, conf.AuthCodeURL(state), http.StatusFound)
+	})
+
+	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("state")
+		if err != nil || oauth.VerifyState(cookie.Value, r.FormValue("state")) != nil {
+			http.Error(w, "invalid state", http.StatusBadRequest)
+			return
+		}
+
+		tok, err := conf.Exchange(r.Context(), r.FormValue("code"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		ts := oauth.NewTokenSource(conf, tok, oauth.Persist(func(ctx context.Context, t *oauth.Token) error {
+			// save the rotated token with the user account
+			return nil
+		}))
+		c := ynab.NewClient("", ynab.TokenSource(ts))
+		u, err := c.User().GetUser(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		fmt.Fprintf(w, "Hello %s", u.ID)
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// expiryDelta how early a token is considered expired, so it does not
+// expire while a request is in flight
+const expiryDelta = 30 * time.Second
+
+// Token an OAuth token granted by YNAB
+type Token struct {
+	// AccessToken the bearer token sent with API requests
+	AccessToken string `json:"access_token"`
+	// TokenType the type of the access token, usually "bearer"
+	TokenType string `json:"token_type,omitempty"`
+	// RefreshToken the token used to obtain a new access token. It is
+	// empty for implicit grants
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Expiry the time the access token expires. The zero value means
+	// the token does not expire
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has an access token which is not about
+// to expire
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+// TokenSource contract for a source of tokens, used by the client to
+// authorize its requests
+type TokenSource interface {
+	// Token returns a valid token, refreshing it when expired
+	Token(ctx context.Context) (*Token, error)
+	// Refresh returns a new token after the API rejected the given one.
+	// Implementations should not refresh again if the token was already
+	// replaced, e.g. by a concurrent request
+	Refresh(ctx context.Context, rejected *Token) (*Token, error)
+}
+
+// NewTokenSource facilitates the creation of a token source refreshing
+// the given token with the configuration
+func NewTokenSource(c *Config, t *Token, options ...func(*RefreshTokenSource)) *RefreshTokenSource {
+	s := &RefreshTokenSource{c: c, t: t}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Persist registers a function saving every new token obtained by the
+// source, e.g. in the user session or a database. As refresh tokens are
+// rotated, a token which is not saved cannot be refreshed after a restart.
+// Calls are serialised by the source
+func Persist(save func(ctx context.Context, t *Token) error) func(*RefreshTokenSource) {
+	return func(s *RefreshTokenSource) {
+		s.save = save
+	}
+}
+
+// RefreshTokenSource a TokenSource refreshing expired tokens with their
+// refresh token. It is safe for concurrent use, only one refresh is
+// performed at a time
+type RefreshTokenSource struct {
+	mu   sync.Mutex
+	c    *Config
+	t    *Token
+	save func(ctx context.Context, t *Token) error
+}
+
+// Token returns the current token, refreshing it when expired
+func (s *RefreshTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t.Valid() {
+		return s.current(), nil
+	}
+	return s.refresh(ctx)
+}
+
+// Refresh refreshes the token unless the rejected token was already
+// replaced by a valid one
+func (s *RefreshTokenSource) Refresh(ctx context.Context, rejected *Token) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rejected != nil && s.t.Valid() && s.t.AccessToken != rejected.AccessToken {
+		return s.current(), nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh obtains and saves a new token, the caller holds the lock. The
+// new token is kept even when saving it fails, since the previous refresh
+// token is no longer valid
+func (s *RefreshTokenSource) refresh(ctx context.Context) (*Token, error) {
+	var refreshToken string
+	if s.t != nil {
+		refreshToken = s.t.RefreshToken
+	}
+
+	t, err := s.c.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	s.t = t
+
+	if s.save != nil {
+		if err := s.save(ctx, s.current()); err != nil {
+			return nil, err
+		}
+	}
+	return s.current(), nil
+}
+
+// current returns a copy of the token, so callers cannot alter the state
+// of the source
+func (s *RefreshTokenSource) current() *Token {
+	t := *s.t
+	return &t
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go/oauth"
+)
+
+func TestToken_Valid(t *testing.T) {
+	table := []struct {
+		name  string
+		token *oauth.Token
+		valid bool
+	}{
+		{name: "nil", token: nil},
+		{name: "empty", token: &oauth.Token{}},
+		{name: "without expiry", token: &oauth.Token{AccessToken: "a"}, valid: true},
+		{name: "not expired", token: &oauth.Token{AccessToken: "a", Expiry: time.Now().Add(time.Hour)}, valid: true},
+		{name: "expired", token: &oauth.Token{AccessToken: "a", Expiry: time.Now().Add(-time.Second)}},
+		{name: "about to expire", token: &oauth.Token{AccessToken: "a", Expiry: time.Now().Add(10 * time.Second)}},
+	}
+
+	for _, test := range table {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.valid, test.token.Valid())
+		})
+	}
+}
+
+// registerRotation registers a token endpoint rotating refresh tokens,
+// r1 is refreshed to a2/r2, r2 to a3/r3 and so on
+func registerRotation(t *testing.T, calls *int) {
+	var mu sync.Mutex
+	httpmock.RegisterResponder(http.MethodPost, oauth.TokenURL,
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			*calls++
+
+			assert.NoError(t, req.ParseForm())
+			var n int
+			_, err := fmt.Sscanf(req.PostForm.Get("refresh_token"), "r%d", &n)
+			assert.NoError(t, err)
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(
+				`{"access_token":"a%d","expires_in":7200,"refresh_token":"r%d"}`, n+1, n+1)), nil
+		},
+	)
+}
+
+func TestRefreshTokenSource_Token(t *testing.T) {
+	t.Run("valid token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "a1", RefreshToken: "r1"})
+		tok, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "a1", tok.AccessToken)
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("expired token is refreshed and persisted", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls int
+		registerRotation(t, &calls)
+
+		var saved []string
+		ts := oauth.NewTokenSource(conf,
+			&oauth.Token{AccessToken: "a1", RefreshToken: "r1", Expiry: time.Now().Add(-time.Minute)},
+			oauth.Persist(func(ctx context.Context, tok *oauth.Token) error {
+				saved = append(saved, tok.RefreshToken)
+				return nil
+			}),
+		)
+
+		for i := 0; i < 2; i++ {
+			tok, err := ts.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "a2", tok.AccessToken)
+			assert.Equal(t, "r2", tok.RefreshToken)
+		}
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, []string{"r2"}, saved)
+	})
+
+	t.Run("persistence failure keeps the rotated token", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls int
+		registerRotation(t, &calls)
+
+		errSave := errors.New("disk full")
+		ts := oauth.NewTokenSource(conf,
+			&oauth.Token{AccessToken: "a1", RefreshToken: "r1", Expiry: time.Now().Add(-time.Minute)},
+			oauth.Persist(func(ctx context.Context, tok *oauth.Token) error {
+				return errSave
+			}),
+		)
+
+		_, err := ts.Token(context.Background())
+		assert.True(t, errors.Is(err, errSave))
+
+		tok, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "r2", tok.RefreshToken)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returned tokens are copies", func(t *testing.T) {
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "a1"})
+		tok, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+		tok.AccessToken = "changed"
+
+		tok, err = ts.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "a1", tok.AccessToken)
+	})
+}
+
+func TestRefreshTokenSource_Refresh(t *testing.T) {
+	t.Run("concurrent rejections refresh once", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		var calls int
+		registerRotation(t, &calls)
+
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "a1", RefreshToken: "r1"})
+		rejected, err := ts.Token(context.Background())
+		assert.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tok, err := ts.Refresh(context.Background(), rejected)
+				assert.NoError(t, err)
+				assert.Equal(t, "a2", tok.AccessToken)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, calls)
+
+		tok, err := ts.Refresh(context.Background(), &oauth.Token{AccessToken: "a2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "a3", tok.AccessToken)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("implicit grant tokens cannot be refreshed", func(t *testing.T) {
+		ts := oauth.NewTokenSource(conf, &oauth.Token{AccessToken: "a1"})
+		_, err := ts.Refresh(context.Background(), &oauth.Token{AccessToken: "a1"})
+		assert.EqualError(t, err, "oauth: no refresh token")
+	})
+}
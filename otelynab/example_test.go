@@ -0,0 +1,35 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package otelynab_test
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/otelynab"
+)
+
+func ExampleNewInterceptor() {
+	i, err := otelynab.NewInterceptor()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c := ynab.NewClient("<valid_ynab_access_token>", ynab.Middleware(i))
+	c.Budget().GetBudgets(context.Background()) //nolint:errcheck
+}
+
+func ExampleAttributes() {
+	i, err := otelynab.NewInterceptor(otelynab.Attributes(attribute.String("tenant", "<tenant_id>")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c := ynab.NewClient("<valid_ynab_access_token>", ynab.Middleware(i))
+	c.Budget().GetBudgets(context.Background()) //nolint:errcheck
+}
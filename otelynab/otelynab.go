@@ -0,0 +1,272 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package otelynab implements the OpenTelemetry instrumentation of the
+// client, as a middleware tracing every service call and recording its
+// latency, errors and rate limit usage
+package otelynab // import "github.com/mellis/ynab.go/otelynab"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mellis/ynab.go"
+)
+
+// instrumentationName the name of the tracer and meter
+const instrumentationName = "github.com/mellis/ynab.go/otelynab"
+
+// TracerProvider sets the provider of the tracer creating the spans,
+// defaults to the global provider
+func TracerProvider(tp trace.TracerProvider) func(*config) {
+	return func(c *config) {
+		c.tp = tp
+	}
+}
+
+// MeterProvider sets the provider of the meter recording the metrics,
+// defaults to the global provider
+func MeterProvider(mp metric.MeterProvider) func(*config) {
+	return func(c *config) {
+		c.mp = mp
+	}
+}
+
+// Attributes adds attributes to every span and measurement, e.g. the
+// tenant the client acts for
+func Attributes(attrs ...attribute.KeyValue) func(*config) {
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
+type config struct {
+	tp    trace.TracerProvider
+	mp    metric.MeterProvider
+	attrs []attribute.KeyValue
+}
+
+// NewInterceptor returns an interceptor instrumenting the requests of a
+// client, installed with ynab.Middleware. Each service call, such as
+// transaction.GetTransactions, gets a client span child of the span of
+// the request context, with the budget ID and the number of entities
+// returned as attributes
+func NewInterceptor(options ...func(*config)) (ynab.Interceptor, error) {
+	c := config{
+		tp: otel.GetTracerProvider(),
+		mp: otel.GetMeterProvider(),
+	}
+	for _, option := range options {
+		option(&c)
+	}
+
+	meter := c.mp.Meter(instrumentationName)
+	duration, err := meter.Float64Histogram("ynab.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of the requests to the YNAB API, retries included"))
+	if err != nil {
+		return nil, err
+	}
+	errorCount, err := meter.Int64Counter("ynab.client.request.errors",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of requests to the YNAB API which failed"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitUsed, err := meter.Int64Gauge("ynab.client.rate_limit.used",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Requests of the access token within the current rate limit window"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitRemaining, err := meter.Int64Gauge("ynab.client.rate_limit.remaining",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Requests left to the access token within the current rate limit window"))
+	if err != nil {
+		return nil, err
+	}
+
+	i := &instrumentation{
+		tracer:             c.tp.Tracer(instrumentationName),
+		attrs:              c.attrs,
+		duration:           duration,
+		errors:             errorCount,
+		rateLimitUsed:      rateLimitUsed,
+		rateLimitRemaining: rateLimitRemaining,
+	}
+	return i.intercept, nil
+}
+
+// instrumentation holds the instruments shared by the requests
+type instrumentation struct {
+	tracer trace.Tracer
+	attrs  []attribute.KeyValue
+
+	duration           metric.Float64Histogram
+	errors             metric.Int64Counter
+	rateLimitUsed      metric.Int64Gauge
+	rateLimitRemaining metric.Int64Gauge
+}
+
+func (i *instrumentation) intercept(next ynab.Handler) ynab.Handler {
+	return func(ctx context.Context, req *ynab.Request) (*ynab.Response, error) {
+		name := req.Operation
+		if name == "" {
+			name = req.Method + " " + req.PathTemplate
+		}
+
+		attrs := append([]attribute.KeyValue{
+			attribute.String("ynab.operation", name),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.template", req.PathTemplate),
+		}, i.attrs...)
+		spanAttrs := attrs[:len(attrs):len(attrs)]
+		if req.BudgetID != "" {
+			spanAttrs = append(spanAttrs, attribute.String("ynab.budget_id", req.BudgetID))
+		}
+
+		ctx, span := i.tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(spanAttrs...),
+		)
+		defer span.End()
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		elapsed := time.Since(start)
+
+		var errorType string
+		switch {
+		case err != nil:
+			errorType = "transport"
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case res.Err != nil:
+			errorType = res.Err.Name
+			span.SetStatus(codes.Error, res.Err.Error())
+		}
+
+		if res != nil {
+			status := attribute.Int("http.response.status_code", res.StatusCode)
+			attrs = append(attrs, status)
+			span.SetAttributes(status)
+			span.SetAttributes(entityCounts(res.Body)...)
+
+			if rl := res.RateLimit; rl != nil {
+				span.SetAttributes(
+					attribute.Int64("ynab.rate_limit.used", int64(rl.Used())),
+					attribute.Int64("ynab.rate_limit.total", int64(rl.Total())),
+				)
+				i.rateLimitUsed.Record(ctx, int64(rl.Used()), metric.WithAttributes(i.attrs...))
+				i.rateLimitRemaining.Record(ctx, int64(rl.Remaining()), metric.WithAttributes(i.attrs...))
+			}
+		}
+		if errorType != "" {
+			attrs = append(attrs, attribute.String("error.type", errorType))
+			i.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		i.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+		return res, err
+	}
+}
+
+// entityCounts returns the number of entities of each collection of the
+// response data, e.g. ynab.transactions.count. The body is scanned once
+// and only the arrays of the data are counted, not the collections nested
+// in its entities
+func entityCounts(body []byte) []attribute.KeyValue {
+	d := json.NewDecoder(bytes.NewReader(body))
+	if !seekData(d) {
+		return nil
+	}
+
+	var attrs []attribute.KeyValue
+	for d.More() {
+		key, err := d.Token()
+		if err != nil {
+			return attrs
+		}
+		tok, err := d.Token()
+		if err != nil {
+			return attrs
+		}
+		if tok != json.Delim('[') {
+			if skipValue(d, tok) != nil {
+				return attrs
+			}
+			continue
+		}
+
+		var n int
+		for d.More() {
+			tok, err := d.Token()
+			if err != nil || skipValue(d, tok) != nil {
+				return attrs
+			}
+			n++
+		}
+		if _, err := d.Token(); err != nil {
+			return attrs
+		}
+		attrs = append(attrs, attribute.Int("ynab."+key.(string)+".count", n))
+	}
+
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	return attrs
+}
+
+// seekData moves the decoder into the data object of a response body
+func seekData(d *json.Decoder) bool {
+	if tok, err := d.Token(); err != nil || tok != json.Delim('{') {
+		return false
+	}
+	for d.More() {
+		key, err := d.Token()
+		if err != nil {
+			return false
+		}
+		tok, err := d.Token()
+		if err != nil {
+			return false
+		}
+		if key == "data" {
+			return tok == json.Delim('{')
+		}
+		if skipValue(d, tok) != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// skipValue consumes the rest of the value starting with tok
+func skipValue(d *json.Decoder, tok json.Token) error {
+	var depth int
+	for {
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+
+		var err error
+		if tok, err = d.Token(); err != nil {
+			return err
+		}
+	}
+}
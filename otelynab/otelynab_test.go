@@ -0,0 +1,226 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package otelynab_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/otelynab"
+)
+
+// instrumented returns a client instrumented with in-memory providers
+func instrumented(t *testing.T) (ynab.ClientServicer, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	i, err := otelynab.NewInterceptor(
+		otelynab.TracerProvider(tp),
+		otelynab.MeterProvider(mp),
+		otelynab.Attributes(attribute.String("tenant", "alice")),
+	)
+	assert.NoError(t, err)
+	return ynab.NewClient("token", ynab.Middleware(i)), sr, reader
+}
+
+// metrics collects the metrics recorded, keyed by name
+func metrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+
+	m := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		assert.Equal(t, "github.com/mellis/ynab.go/otelynab", sm.Scope.Name)
+		for _, metric := range sm.Metrics {
+			m[metric.Name] = metric.Data
+		}
+	}
+	return m
+}
+
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestNewInterceptor(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, "https://api.youneedabudget.com/v1/budgets/aa248caa/transactions",
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusOK, `{
+  "data": {
+    "transactions": [
+      {"id": "e6ad88f5", "date": "2018-03-10", "amount": -43000, "subtransactions": []},
+      {"id": "a7a5a2b9", "date": "2018-03-11", "amount": -1000, "subtransactions": []}
+    ],
+    "server_knowledge": 12
+  }
+}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		c, sr, reader := instrumented(t)
+
+		parentTP := sdktrace.NewTracerProvider()
+		ctx, parent := parentTP.Tracer("test").Start(context.Background(), "sync")
+		transactions, _, err := c.Transaction().GetTransactions(ctx, "aa248caa", nil)
+		parent.End()
+		assert.NoError(t, err)
+		assert.Len(t, transactions, 2)
+
+		spans := sr.Ended()
+		assert.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "transaction.GetTransactions", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Equal(t, parent.SpanContext().TraceID(), span.Parent().TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+
+		attrs := attributes(span.Attributes())
+		assert.Equal(t, "transaction.GetTransactions", attrs["ynab.operation"].AsString())
+		assert.Equal(t, "GET", attrs["http.request.method"].AsString())
+		assert.Equal(t, "/budgets/{budget_id}/transactions", attrs["url.template"].AsString())
+		assert.Equal(t, "aa248caa", attrs["ynab.budget_id"].AsString())
+		assert.Equal(t, "alice", attrs["tenant"].AsString())
+		assert.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+		assert.Equal(t, int64(2), attrs["ynab.transactions.count"].AsInt64())
+		assert.Equal(t, int64(36), attrs["ynab.rate_limit.used"].AsInt64())
+		assert.Equal(t, int64(200), attrs["ynab.rate_limit.total"].AsInt64())
+
+		m := metrics(t, reader)
+		duration := m["ynab.client.request.duration"].(metricdata.Histogram[float64])
+		assert.Len(t, duration.DataPoints, 1)
+		assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+		point := attributes(duration.DataPoints[0].Attributes.ToSlice())
+		assert.Equal(t, "transaction.GetTransactions", point["ynab.operation"].AsString())
+		assert.Equal(t, int64(200), point["http.response.status_code"].AsInt64())
+		assert.Equal(t, "alice", point["tenant"].AsString())
+		_, ok := point["ynab.budget_id"]
+		assert.False(t, ok)
+
+		used := m["ynab.client.rate_limit.used"].(metricdata.Gauge[int64])
+		assert.Equal(t, int64(36), used.DataPoints[0].Value)
+		remaining := m["ynab.client.rate_limit.remaining"].(metricdata.Gauge[int64])
+		assert.Equal(t, int64(164), remaining.DataPoints[0].Value)
+
+		_, ok = m["ynab.client.request.errors"]
+		assert.False(t, ok)
+	})
+
+	t.Run("nested collections are not counted", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodGet, "https://api.youneedabudget.com/v1/budgets/aa248caa",
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusOK, `{
+  "data": {
+    "budget": {
+      "id": "aa248caa",
+      "name": "My Budget",
+      "accounts": [{"id": "09eaca5e", "name": "Checking"}],
+      "transactions": []
+    },
+    "server_knowledge": 12
+  }
+}`)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+
+		c, sr, _ := instrumented(t)
+		_, err := c.Budget().GetBudget(context.Background(), "aa248caa", nil)
+		assert.NoError(t, err)
+
+		spans := sr.Ended()
+		assert.Len(t, spans, 1)
+		for _, kv := range spans[0].Attributes() {
+			assert.NotContains(t, string(kv.Key), ".count")
+		}
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(http.MethodPut,
+			"https://api.youneedabudget.com/v1/budgets/aa248caa/months/2018-03-01/categories/13419c12",
+			func(req *http.Request) (*http.Response, error) {
+				res := httpmock.NewStringResponse(http.StatusNotFound,
+					`{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`)
+				res.Header.Add("X-Rate-Limit", "37/200")
+				return res, nil
+			},
+		)
+
+		c, sr, reader := instrumented(t)
+		month, err := api.DateFromString("2018-03-01")
+		assert.NoError(t, err)
+		_, err = c.Category().UpdateCategoryForMonth(context.Background(), "aa248caa", "13419c12", month,
+			category.PayloadMonthCategory{Budgeted: 1000})
+		assert.Error(t, err)
+
+		spans := sr.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "category.UpdateCategoryForMonth", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, int64(404), attributes(spans[0].Attributes())["http.response.status_code"].AsInt64())
+
+		m := metrics(t, reader)
+		errors := m["ynab.client.request.errors"].(metricdata.Sum[int64])
+		assert.Len(t, errors.DataPoints, 1)
+		assert.Equal(t, int64(1), errors.DataPoints[0].Value)
+		point := attributes(errors.DataPoints[0].Attributes.ToSlice())
+		assert.Equal(t, "resource_not_found", point["error.type"].AsString())
+		assert.Equal(t, "category.UpdateCategoryForMonth", point["ynab.operation"].AsString())
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		c, sr, reader := instrumented(t)
+		_, err := c.User().GetUser(context.Background())
+		assert.Error(t, err)
+
+		spans := sr.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "user.GetUser", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Len(t, spans[0].Events(), 1)
+		_, ok := attributes(spans[0].Attributes())["ynab.budget_id"]
+		assert.False(t, ok)
+
+		errors := metrics(t, reader)["ynab.client.request.errors"].(metricdata.Sum[int64])
+		assert.Equal(t, "transport",
+			attributes(errors.DataPoints[0].Attributes.ToSlice())["error.type"].AsString())
+	})
+}
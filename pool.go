@@ -0,0 +1,184 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+// NewPool facilitates the creation of a new pool of clients
+func NewPool(options ...func(*Pool)) *Pool {
+	p := &Pool{
+		clients: make(map[string]*client),
+		now:     time.Now,
+	}
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// ClientOptions sets the options applied to every client created by the
+// pool, e.g. RateLimiter, which then limits each tenant independently
+func ClientOptions(options ...func(*client)) func(*Pool) {
+	return func(p *Pool) {
+		p.options = append(p.options, options...)
+	}
+}
+
+// IdleTimeout evicts the clients which did not send a request for the
+// given duration. Zero, the default, keeps clients forever
+func IdleTimeout(d time.Duration) func(*Pool) {
+	return func(p *Pool) {
+		p.idleTimeout = d
+	}
+}
+
+// Pool keeps one client per tenant for services acting on behalf of many
+// users. Clients are created on first use and share the HTTP client set
+// with the HTTPClient option, or http.DefaultClient. Tenants are
+// identified by a key chosen by the caller, such as a user ID, so access
+// tokens never leave the pool.
+// It is safe for concurrent use
+type Pool struct {
+	mu          sync.Mutex
+	clients     map[string]*client
+	options     []func(*client)
+	idleTimeout time.Duration
+
+	now func() time.Time
+}
+
+// Stats the usage statistics of a pooled client
+type Stats struct {
+	// Requests the number of requests sent, retries excluded
+	Requests uint64
+	// Errors the number of requests which failed
+	Errors uint64
+	// RateLimit the last rate limit state of the access token, nil until
+	// a request succeeded. RateLimit.Remaining is the quota left
+	RateLimit *api.RateLimit
+	// LastUsed the time the last request was sent
+	LastUsed time.Time
+}
+
+// Client returns the client of the tenant, creating it on first use with
+// the access token. Should the access token of the tenant change, e.g.
+// after the user authorized the application again, the client is replaced
+// and the statistics of the tenant carried over
+func (p *Pool) Client(tenantID, accessToken string) ClientServicer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.evictIdle()
+	prev, ok := p.clients[tenantID]
+	if ok && prev.accessToken == accessToken {
+		return prev
+	}
+
+	c := NewClient(accessToken, p.options...).(*client)
+	if ok {
+		c.stats = prev.stats
+	} else {
+		c.stats = &clientStats{now: p.now}
+	}
+	c.stats.touch()
+	p.clients[tenantID] = c
+	return c
+}
+
+// Remove evicts the client of the tenant, e.g. once the user revoked the
+// application
+func (p *Pool) Remove(tenantID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, tenantID)
+}
+
+// EvictIdle evicts the clients idle for longer than the idle timeout and
+// returns how many were evicted. Idle clients are also evicted whenever
+// Client is called
+func (p *Pool) EvictIdle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.evictIdle()
+}
+
+// Len returns the number of clients in the pool
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.clients)
+}
+
+// Stats returns the statistics of every client in the pool, keyed by
+// tenant. The statistics of evicted clients are dropped
+func (p *Pool) Stats() map[string]*Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make(map[string]*Stats, len(p.clients))
+	for tenantID, c := range p.clients {
+		stats[tenantID] = c.stats.snapshot(c.RateLimit())
+	}
+	return stats
+}
+
+// evictIdle evicts idle clients, the caller holds the lock
+func (p *Pool) evictIdle() int {
+	if p.idleTimeout <= 0 {
+		return 0
+	}
+
+	var evicted int
+	deadline := p.now().Add(-p.idleTimeout)
+	for tenantID, c := range p.clients {
+		if c.stats.lastUsed().Before(deadline) {
+			delete(p.clients, tenantID)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// clientStats counts the requests of a pooled client
+type clientStats struct {
+	requests atomic.Uint64
+	errors   atomic.Uint64
+	// used the last time a request was sent, in Unix nanoseconds
+	used atomic.Int64
+
+	now func() time.Time
+}
+
+// record counts a request and its outcome
+func (s *clientStats) record(err error) {
+	s.touch()
+	s.requests.Add(1)
+	if err != nil {
+		s.errors.Add(1)
+	}
+}
+
+func (s *clientStats) touch() {
+	s.used.Store(s.now().UnixNano())
+}
+
+func (s *clientStats) lastUsed() time.Time {
+	return time.Unix(0, s.used.Load())
+}
+
+func (s *clientStats) snapshot(rl *api.RateLimit) *Stats {
+	return &Stats{
+		Requests:  s.requests.Load(),
+		Errors:    s.errors.Load(),
+		RateLimit: rl,
+		LastUsed:  s.lastUsed(),
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+// registerTenants registers a /foo endpoint reporting a different rate
+// limit per access token, failing for unknown tokens
+func registerTenants() {
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			switch req.Header.Get("Authorization") {
+			case "Bearer alice-token":
+				res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+				res.Header.Add("X-Rate-Limit", "10/200")
+				return res, nil
+			case "Bearer bob-token":
+				res := httpmock.NewStringResponse(http.StatusOK, `{}`)
+				res.Header.Add("X-Rate-Limit", "150/200")
+				return res, nil
+			}
+			res := httpmock.NewStringResponse(http.StatusUnauthorized,
+				`{"error":{"id":"401","name":"unauthorized","detail":"Unauthorized"}}`)
+			res.Header.Add("X-Rate-Limit", "1/200")
+			return res, nil
+		},
+	)
+}
+
+func TestPool_Client(t *testing.T) {
+	hc := &http.Client{}
+	p := NewPool(ClientOptions(HTTPClient(hc), RateLimiter(0)))
+
+	alice := p.Client("alice", "alice-token")
+	assert.True(t, alice == p.Client("alice", "alice-token"))
+
+	bob := p.Client("bob", "bob-token")
+	assert.True(t, alice != bob)
+	assert.Equal(t, 2, p.Len())
+
+	assert.True(t, hc == alice.(*client).client)
+	assert.True(t, hc == bob.(*client).client)
+	assert.True(t, alice.(*client).limiter != bob.(*client).limiter)
+
+	p.Remove("alice")
+	assert.Equal(t, 1, p.Len())
+	assert.True(t, alice != p.Client("alice", "alice-token"))
+}
+
+func TestPool_Client_tokenChange(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerTenants()
+
+	p := NewPool()
+	old := p.Client("alice", "revoked-token")
+	assert.Error(t, old.(*client).Get(context.Background(), "/foo", nil))
+
+	c := p.Client("alice", "alice-token")
+	assert.True(t, c != old)
+	assert.Equal(t, "alice-token", c.(*client).accessToken)
+	assert.NoError(t, c.(*client).Get(context.Background(), "/foo", nil))
+	assert.Equal(t, 1, p.Len())
+
+	stats := p.Stats()["alice"]
+	assert.Equal(t, uint64(2), stats.Requests)
+	assert.Equal(t, uint64(1), stats.Errors)
+}
+
+func TestPool_Stats(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerTenants()
+
+	p := NewPool()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, p.Client("alice", "alice-token").(*client).Get(ctx, "/foo", nil))
+	}
+	assert.NoError(t, p.Client("bob", "bob-token").(*client).Get(ctx, "/foo", nil))
+	assert.Error(t, p.Client("bad", "bad-token").(*client).Get(ctx, "/foo", nil))
+	p.Client("idle", "idle-token")
+
+	stats := p.Stats()
+	assert.Len(t, stats, 4)
+	for tenantID := range stats {
+		assert.NotContains(t, tenantID, "token")
+	}
+
+	assert.Equal(t, uint64(3), stats["alice"].Requests)
+	assert.Equal(t, uint64(0), stats["alice"].Errors)
+	assert.Equal(t, uint64(190), stats["alice"].RateLimit.Remaining())
+
+	assert.Equal(t, uint64(1), stats["bob"].Requests)
+	assert.Equal(t, uint64(50), stats["bob"].RateLimit.Remaining())
+
+	assert.Equal(t, uint64(1), stats["bad"].Requests)
+	assert.Equal(t, uint64(1), stats["bad"].Errors)
+
+	assert.Equal(t, uint64(0), stats["idle"].Requests)
+	assert.Nil(t, stats["idle"].RateLimit)
+}
+
+func TestPool_concurrent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerTenants()
+
+	p := NewPool()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token := "alice"
+			if i%2 == 0 {
+				token = "bob"
+			}
+			assert.NoError(t, p.Client(token, token+"-token").(*client).Get(context.Background(), "/foo", nil))
+			p.Stats()
+		}(i)
+	}
+	wg.Wait()
+
+	stats := p.Stats()
+	assert.Equal(t, uint64(10), stats["alice"].Requests)
+	assert.Equal(t, uint64(10), stats["bob"].Requests)
+}
+
+func TestPool_EvictIdle(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerTenants()
+
+	now := time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := NewPool(IdleTimeout(time.Hour))
+	p.now = func() time.Time { return now }
+
+	alice := p.Client("alice", "alice-token")
+	p.Client("bob", "bob-token")
+
+	now = now.Add(45 * time.Minute)
+	assert.NoError(t, alice.(*client).Get(context.Background(), "/foo", nil))
+
+	now = now.Add(30 * time.Minute)
+	assert.Equal(t, 1, p.EvictIdle())
+	assert.Equal(t, 1, p.Len())
+	assert.True(t, alice == p.Client("alice", "alice-token"))
+
+	now = now.Add(2 * time.Hour)
+	p.Client("bob", "bob-token")
+	assert.Equal(t, 1, p.Len())
+	_, ok := p.Stats()["alice"]
+	assert.False(t, ok)
+
+	t.Run("without idle timeout", func(t *testing.T) {
+		p := NewPool()
+		p.now = func() time.Time { return now }
+		p.Client("alice", "alice-token")
+		now = now.Add(24 * time.Hour)
+		assert.Equal(t, 0, p.EvictIdle())
+		assert.Equal(t, 1, p.Len())
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+const (
+	// rateLimitWindow the rolling window of the YNAB API rate limit
+	rateLimitWindow = time.Hour
+	// rateLimitTotal the number of requests allowed by the YNAB API
+	// within rateLimitWindow, until a response states otherwise
+	rateLimitTotal = 200
+)
+
+var errRateLimitDeadline = fmt.Errorf("%w: quota would not free up before the context deadline", api.ErrRateLimited)
+
+// RateLimiter enables a client-side rate limiter which blocks requests,
+// for as long as their context allows, once the rolling hour quota of the
+// access token is used up. reserve is the number of requests kept unused,
+// e.g. for other applications sharing the same token.
+// When enabled, ClientServicer.RateLimit reflects the limiter state,
+// including when quota frees up again.
+func RateLimiter(reserve uint64) func(*client) {
+	return func(c *client) {
+		c.limiter = newRateLimiter(reserve)
+	}
+}
+
+// rateLimiter keeps a log of the requests sent within the rolling rate
+// limit window. Each logged request holds one token of the quota, given
+// back once the request leaves the window
+type rateLimiter struct {
+	sync.Mutex
+
+	window  time.Duration
+	total   uint64
+	reserve uint64
+	// sent the ascending send times of the requests within the window
+	sent []time.Time
+
+	now func() time.Time
+}
+
+func newRateLimiter(reserve uint64) *rateLimiter {
+	return &rateLimiter{
+		window:  rateLimitWindow,
+		total:   rateLimitTotal,
+		reserve: reserve,
+		now:     time.Now,
+	}
+}
+
+// wait blocks until a request can be sent and takes a token for it
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.Lock()
+		now := l.now()
+		l.expire(now)
+		if len(l.sent) == 0 || uint64(len(l.sent))+l.reserve < l.total {
+			l.sent = append(l.sent, now)
+			l.Unlock()
+			return nil
+		}
+		d := l.sent[0].Add(l.window).Sub(now)
+		l.Unlock()
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Sub(now) < d {
+			return errRateLimitDeadline
+		}
+
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// observe reconciles the log with the rate limit reported by the API,
+// which also counts requests sent by other clients. Unknown requests are
+// assumed to have been sent just now, as the safest guess
+func (l *rateLimiter) observe(rl *api.RateLimit) {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	l.expire(now)
+	l.total = rl.Total()
+
+	used := rl.Used()
+	for uint64(len(l.sent)) < used {
+		l.sent = append(l.sent, now)
+	}
+	if uint64(len(l.sent)) > used {
+		l.sent = l.sent[uint64(len(l.sent))-used:]
+	}
+}
+
+// snapshot returns the current state of the limiter as a *api.RateLimit
+func (l *rateLimiter) snapshot() *api.RateLimit {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	l.expire(now)
+
+	resetAt := now
+	if len(l.sent) > 0 {
+		resetAt = l.sent[0].Add(l.window)
+	}
+	return api.NewRateLimit(uint64(len(l.sent)), l.total, resetAt)
+}
+
+// expire drops the requests that left the window
+func (l *rateLimiter) expire(now time.Time) {
+	i := 0
+	for i < len(l.sent) && !l.sent[i].Add(l.window).After(now) {
+		i++
+	}
+	l.sent = l.sent[i:]
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go/api"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("tokens are given back once requests leave the window", func(t *testing.T) {
+		now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+		l := newRateLimiter(0)
+		l.total = 2
+		l.now = func() time.Time { return now }
+
+		assert.NoError(t, l.wait(context.Background()))
+		now = now.Add(10 * time.Minute)
+		assert.NoError(t, l.wait(context.Background()))
+
+		rl := l.snapshot()
+		assert.Equal(t, uint64(2), rl.Used())
+		assert.Equal(t, uint64(0), rl.Remaining())
+		assert.Equal(t, time.Date(2018, 10, 1, 13, 0, 0, 0, time.UTC), rl.ResetAt())
+
+		now = now.Add(50 * time.Minute)
+		rl = l.snapshot()
+		assert.Equal(t, uint64(1), rl.Used())
+		assert.Equal(t, time.Date(2018, 10, 1, 13, 10, 0, 0, time.UTC), rl.ResetAt())
+	})
+
+	t.Run("blocks until the context deadline when quota is used up", func(t *testing.T) {
+		l := newRateLimiter(1)
+		l.total = 2
+		assert.NoError(t, l.wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.Equal(t, errRateLimitDeadline, l.wait(ctx))
+
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		l.window = time.Second
+		assert.Equal(t, context.Canceled, l.wait(ctx))
+	})
+
+	t.Run("blocks until a token is given back", func(t *testing.T) {
+		l := newRateLimiter(0)
+		l.total = 1
+		l.window = 20 * time.Millisecond
+
+		started := time.Now()
+		assert.NoError(t, l.wait(context.Background()))
+		assert.NoError(t, l.wait(context.Background()))
+		assert.True(t, time.Since(started) >= 20*time.Millisecond)
+	})
+
+	t.Run("reconciles with the API rate limit", func(t *testing.T) {
+		now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+		l := newRateLimiter(0)
+		l.now = func() time.Time { return now }
+
+		assert.NoError(t, l.wait(context.Background()))
+		l.observe(api.NewRateLimit(36, 200, time.Time{}))
+		assert.Equal(t, uint64(36), l.snapshot().Used())
+		assert.Equal(t, uint64(164), l.snapshot().Remaining())
+
+		l.observe(api.NewRateLimit(10, 100, time.Time{}))
+		assert.Equal(t, uint64(10), l.snapshot().Used())
+		assert.Equal(t, uint64(100), l.snapshot().Total())
+	})
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	used := 198
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			used++
+			res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+			res.Header.Add("X-Rate-Limit", fmt.Sprintf("%d/200", used))
+			return res, nil
+		},
+	)
+
+	c := NewClient("", RateLimiter(0))
+	assert.NoError(t, c.(*client).Get(context.Background(), "/foo", nil))
+	assert.Equal(t, uint64(1), c.RateLimit().Remaining())
+	assert.True(t, c.RateLimit().ResetAt().After(time.Now()))
+
+	assert.NoError(t, c.(*client).Get(context.Background(), "/foo", nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := c.(*client).Get(ctx, "/foo", nil)
+	assert.Equal(t, errRateLimitDeadline, err)
+	assert.True(t, errors.Is(err, api.ErrRateLimited))
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var errRetryDeadline = errors.New("ynab: retry would exceed the context deadline")
+
+// RetryPolicy describes how the client retries requests that failed
+// with a 429 Too Many Requests, a 5xx status or a transport error
+type RetryPolicy struct {
+	// MaxAttempts the maximum number of attempts for a request, including
+	// the first one. Values lower than 2 disable retries
+	MaxAttempts int
+	// MinBackoff the delay before the first retry, doubled on every
+	// following attempt
+	MinBackoff time.Duration
+	// MaxBackoff the upper bound of the delay between two attempts. It does
+	// not apply to delays requested by the API through Retry-After
+	MaxBackoff time.Duration
+	// RetryPost also retries POST requests, which are not idempotent by
+	// default. Only enable it when every created transaction carries an
+	// import_id, so the API discards duplicates
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most clients
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// Retry enables retries of failed requests following the given policy.
+// Only idempotent methods (GET, PUT, DELETE) are retried unless the policy
+// opts in for POST. Retries never outlive the request context deadline.
+func Retry(p RetryPolicy) func(*client) {
+	return func(c *client) {
+		c.retry = &p
+	}
+}
+
+// allows reports whether the attempt that produced res and err should be
+// followed by another one
+func (p *RetryPolicy) allows(ctx context.Context, method string, attempt int, res *http.Response, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+	case http.MethodPost:
+		if !p.RetryPost {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, errRateLimitDeadline)
+	}
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+}
+
+// wait blocks until the next attempt can be sent. It returns
+// errRetryDeadline without waiting when the delay would exceed
+// the context deadline
+func (p *RetryPolicy) wait(ctx context.Context, attempt int, res *http.Response) error {
+	d := p.backoff(attempt)
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			d = retryAfter
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return errRetryDeadline
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// backoff returns the exponential delay before the given attempt is
+// retried, with half of it randomised to spread concurrent clients
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		if (p.MaxBackoff > 0 && d >= p.MaxBackoff) || d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	half := int64(d / 2)
+	return time.Duration(half + rand.Int63n(half+1)) //nolint:gosec
+}
+
+// parseRetryAfter parses a Retry-After header value, expressed either
+// in seconds or as an HTTP date
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(v, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  2 * time.Millisecond,
+	}
+}
+
+// registerFlakyResponder responds with the given statuses in order,
+// then with a successful response
+func registerFlakyResponder(method string, statuses ...int) *int {
+	calls := 0
+	httpmock.RegisterResponder(method, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls <= len(statuses) {
+				return httpmock.NewStringResponse(statuses[calls-1], `{
+  "error": {
+    "id": "429",
+    "name": "too_many_requests",
+    "detail": "Too many requests"
+  }
+}`), nil
+			}
+			res := httpmock.NewStringResponse(http.StatusOK, `{"foo":"bar"}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+	return &calls
+}
+
+func TestClient_Retry(t *testing.T) {
+	t.Run("success after retrying a GET request", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodGet, http.StatusTooManyRequests, http.StatusServiceUnavailable)
+
+		response := struct {
+			Foo string `json:"foo"`
+		}{}
+
+		c := NewClient("", Retry(testRetryPolicy()))
+		err := c.(*client).Get(context.Background(), "/foo", &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", response.Foo)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("failure after exhausting attempts", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodPut, http.StatusInternalServerError,
+			http.StatusInternalServerError, http.StatusInternalServerError)
+
+		c := NewClient("", Retry(testRetryPolicy()))
+		err := c.(*client).Put(context.Background(), "/foo", nil, []byte(`{"bar":"foo"}`))
+		assert.EqualError(t, err, "api: error id=429 name=too_many_requests detail=Too many requests")
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodGet, http.StatusBadRequest)
+
+		c := NewClient("", Retry(testRetryPolicy()))
+		err := c.(*client).Get(context.Background(), "/foo", nil)
+		assert.Error(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("POST and PATCH are not retried by default", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPatch} {
+			httpmock.Activate()
+
+			calls := registerFlakyResponder(method, http.StatusServiceUnavailable)
+
+			c := NewClient("", Retry(testRetryPolicy()))
+			err := c.(*client).do(context.Background(), method, "/foo", nil, []byte(`{"bar":"foo"}`))
+			assert.Error(t, err, method)
+			assert.Equal(t, 1, *calls, method)
+
+			httpmock.DeactivateAndReset()
+		}
+	})
+
+	t.Run("POST is retried when opted in", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodPost, http.StatusServiceUnavailable)
+
+		p := testRetryPolicy()
+		p.RetryPost = true
+		c := NewClient("", Retry(p))
+		err := c.(*client).Post(context.Background(), "/foo", nil, []byte(`{"bar":"foo"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("no retries without a policy", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := registerFlakyResponder(http.MethodGet, http.StatusServiceUnavailable)
+
+		c := NewClient("")
+		err := c.(*client).Get(context.Background(), "/foo", nil)
+		assert.Error(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("Retry-After beyond the context deadline stops retrying", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		calls := 0
+		httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/foo"),
+			func(req *http.Request) (*http.Response, error) {
+				calls++
+				res := httpmock.NewStringResponse(http.StatusTooManyRequests, "")
+				res.Header.Add("Retry-After", "3600")
+				return res, nil
+			},
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		started := time.Now()
+		c := NewClient("", Retry(testRetryPolicy()))
+		err := c.(*client).Get(ctx, "/foo", nil)
+		assert.EqualError(t, err, "api: error id=429 name=unknown_api_error detail=Unknown API error")
+		assert.Equal(t, 1, calls)
+		assert.True(t, time.Since(started) < time.Second)
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	table := []struct {
+		Attempt int
+		Max     time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{100, time.Second},
+	}
+
+	for _, test := range table {
+		d := p.backoff(test.Attempt)
+		assert.True(t, d >= test.Max/2, "attempt %d: %s", test.Attempt, d)
+		assert.True(t, d <= test.Max, "attempt %d: %s", test.Attempt, d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	table := []struct {
+		In  string
+		Out time.Duration
+		OK  bool
+	}{
+		{"120", 2 * time.Minute, true},
+		{"0", 0, true},
+		{"Mon, 01 Oct 2018 12:00:30 GMT", 30 * time.Second, true},
+		{"Mon, 01 Oct 2018 11:00:00 GMT", 0, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"soon", 0, false},
+	}
+
+	for _, test := range table {
+		d, ok := parseRetryAfter(test.In, now)
+		assert.Equal(t, test.OK, ok, test.In)
+		assert.Equal(t, test.Out, d, test.In)
+	}
+}
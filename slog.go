@@ -0,0 +1,154 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys the JSON keys holding amounts or names, masked in the
+// bodies dumped at debug level
+var sensitiveKeys = map[string]bool{
+	"amount":                     true,
+	"balance":                    true,
+	"cleared_balance":            true,
+	"uncleared_balance":          true,
+	"budgeted":                   true,
+	"activity":                   true,
+	"income":                     true,
+	"to_be_budgeted":             true,
+	"goal_target":                true,
+	"goal_under_funded":          true,
+	"goal_overall_funded":        true,
+	"goal_overall_left":          true,
+	"name":                       true,
+	"memo":                       true,
+	"note":                       true,
+	"payee_name":                 true,
+	"account_name":               true,
+	"category_name":              true,
+	"import_payee_name":          true,
+	"import_payee_name_original": true,
+	"latitude":                   true,
+	"longitude":                  true,
+}
+
+var bearerPattern = regexp.MustCompile(`(?i)bearer\s+\S+`)
+
+// Slog logs every request through l, with its method, path template,
+// status, duration and rate limit usage. Access tokens, amounts and names
+// are never logged. When dumpBodies is set and l is enabled for debug,
+// request and response bodies are also logged with amounts and names
+// masked
+func Slog(l *slog.Logger, dumpBodies bool) func(*client) {
+	return func(c *client) {
+		c.interceptors = append(c.interceptors, slogInterceptor(l, dumpBodies, c))
+	}
+}
+
+func slogInterceptor(l *slog.Logger, dumpBodies bool, c *client) Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			d := time.Since(start)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.PathTemplate),
+				slog.Duration("duration", d),
+			}
+			level := slog.LevelInfo
+			switch {
+			case err != nil:
+				level = slog.LevelError
+				attrs = append(attrs, slog.String("error", redactToken(err.Error(), c.accessToken)))
+			case res.Err != nil:
+				level = slog.LevelError
+				attrs = append(attrs,
+					slog.Int("status", res.StatusCode),
+					slog.String("error", res.Err.Name),
+					slog.String("error_id", res.Err.ID),
+				)
+			default:
+				attrs = append(attrs, slog.Int("status", res.StatusCode))
+			}
+			if res != nil && res.RateLimit != nil {
+				attrs = append(attrs, slog.Group("rate_limit",
+					slog.Uint64("used", res.RateLimit.Used()),
+					slog.Uint64("total", res.RateLimit.Total()),
+				))
+			}
+			l.LogAttrs(ctx, level, "ynab: request", attrs...)
+
+			if dumpBodies && l.Enabled(ctx, slog.LevelDebug) {
+				attrs := []slog.Attr{
+					slog.String("method", req.Method),
+					slog.String("path", req.PathTemplate),
+					slog.String("request_body", maskBody(req.Body)),
+				}
+				if res != nil {
+					attrs = append(attrs, slog.String("response_body", maskBody(res.Body)))
+				}
+				l.LogAttrs(ctx, slog.LevelDebug, "ynab: bodies", attrs...)
+			}
+			return res, err
+		}
+	}
+}
+
+// redactToken removes the access token and any bearer credentials from s
+func redactToken(s, accessToken string) string {
+	if accessToken != "" {
+		s = strings.ReplaceAll(s, accessToken, redacted)
+	}
+	return bearerPattern.ReplaceAllString(s, "Bearer "+redacted)
+}
+
+// maskBody returns the JSON body with the values of sensitive keys masked
+func maskBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return redacted
+	}
+
+	b, err := json.Marshal(mask(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func mask(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			if e != nil && sensitiveKeys[k] {
+				t[k] = redacted
+				continue
+			}
+			t[k] = mask(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = mask(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+const slogToken = "6zL9vh8]B9H3BEecwL%Vzh^VwKR3C2CNZ3Bv%=fFxm$z)duY[U+2=3CydZrkQFnA"
+
+// slogRecords decodes the records written by a JSON handler
+func slogRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		record := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func registerSlogResponders() {
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%s%s", apiEndpoint, "/budgets/aa248caa/transactions"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusCreated, `{
+  "data": {
+    "transaction": {
+      "id": "e6ad88f5",
+      "date": "2018-03-10",
+      "amount": -43000,
+      "memo": "Birthday present",
+      "payee_name": "Toy store",
+      "subtransactions": [{"amount": -3000, "memo": null}]
+    },
+    "server_knowledge": 12
+  }
+}`)
+			res.Header.Add("X-Rate-Limit", "36/200")
+			return res, nil
+		},
+	)
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/budgets/aa248caa/accounts/unknown"),
+		func(req *http.Request) (*http.Response, error) {
+			res := httpmock.NewStringResponse(http.StatusNotFound,
+				`{"error":{"id":"404.2","name":"resource_not_found","detail":"Resource not found"}}`)
+			res.Header.Add("X-Rate-Limit", "37/200")
+			return res, nil
+		},
+	)
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("%s%s", apiEndpoint, "/user"),
+		httpmock.NewErrorResponder(errors.New("connection reset, sent Authorization: Bearer "+slogToken)))
+}
+
+func TestSlog(t *testing.T) {
+	t.Run("info", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		registerSlogResponders()
+
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, nil))
+		c := NewClient(slogToken, Slog(l, true)).(*client)
+
+		ctx := context.Background()
+		assert.NoError(t, c.Post(ctx, "/budgets/aa248caa/transactions", nil,
+			[]byte(`{"transaction":{"amount":-43000,"memo":"Birthday present"}}`)))
+		assert.Error(t, c.Get(ctx, "/budgets/aa248caa/accounts/unknown", nil))
+		assert.Error(t, c.Get(ctx, "/user", nil))
+
+		out := buf.String()
+		assert.NotContains(t, out, slogToken)
+		assert.NotContains(t, out, "Birthday")
+		assert.NotContains(t, out, "aa248caa")
+
+		records := slogRecords(t, &buf)
+		assert.Len(t, records, 3)
+
+		assert.Equal(t, "INFO", records[0]["level"])
+		assert.Equal(t, "ynab: request", records[0]["msg"])
+		assert.Equal(t, "POST", records[0]["method"])
+		assert.Equal(t, "/budgets/{budget_id}/transactions", records[0]["path"])
+		assert.Equal(t, float64(201), records[0]["status"])
+		assert.Contains(t, records[0], "duration")
+		assert.Equal(t, map[string]interface{}{"used": float64(36), "total": float64(200)}, records[0]["rate_limit"])
+
+		assert.Equal(t, "ERROR", records[1]["level"])
+		assert.Equal(t, "/budgets/{budget_id}/accounts/{account_id}", records[1]["path"])
+		assert.Equal(t, float64(404), records[1]["status"])
+		assert.Equal(t, "resource_not_found", records[1]["error"])
+		assert.Equal(t, "404.2", records[1]["error_id"])
+
+		assert.Equal(t, "ERROR", records[2]["level"])
+		assert.Equal(t, "/user", records[2]["path"])
+		assert.Contains(t, records[2]["error"], "Bearer [REDACTED]")
+		assert.NotContains(t, records[2], "status")
+	})
+
+	t.Run("debug with bodies", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		registerSlogResponders()
+
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		c := NewClient(slogToken, Slog(l, true)).(*client)
+
+		assert.NoError(t, c.Post(context.Background(), "/budgets/aa248caa/transactions", nil,
+			[]byte(`{"transaction":{"account_id":"09eaca5e","amount":-43000,"memo":"Birthday present"}}`)))
+
+		out := buf.String()
+		assert.NotContains(t, out, slogToken)
+		assert.NotContains(t, out, "Birthday")
+		assert.NotContains(t, out, "Toy store")
+
+		records := slogRecords(t, &buf)
+		assert.Len(t, records, 2)
+		assert.Equal(t, "DEBUG", records[1]["level"])
+		assert.Equal(t, "ynab: bodies", records[1]["msg"])
+		assert.JSONEq(t,
+			`{"transaction":{"account_id":"09eaca5e","amount":"[REDACTED]","memo":"[REDACTED]"}}`,
+			records[1]["request_body"].(string))
+		assert.JSONEq(t, `{
+  "data": {
+    "transaction": {
+      "id": "e6ad88f5",
+      "date": "2018-03-10",
+      "amount": "[REDACTED]",
+      "memo": "[REDACTED]",
+      "payee_name": "[REDACTED]",
+      "subtransactions": [{"amount": "[REDACTED]", "memo": null}]
+    },
+    "server_knowledge": 12
+  }
+}`, records[1]["response_body"].(string))
+	})
+
+	t.Run("debug without bodies", func(t *testing.T) {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		registerSlogResponders()
+
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		c := NewClient(slogToken, Slog(l, false)).(*client)
+
+		assert.NoError(t, c.Post(context.Background(), "/budgets/aa248caa/transactions", nil, []byte(`{}`)))
+		assert.Len(t, slogRecords(t, &buf), 1)
+	})
+}
+
+func TestMaskBody(t *testing.T) {
+	assert.Equal(t, "", maskBody(nil))
+	assert.Equal(t, redacted, maskBody([]byte(`not json`)))
+	assert.JSONEq(t, `[{"name":"[REDACTED]","balance":"[REDACTED]","deleted":false,"note":null}]`,
+		maskBody([]byte(`[{"name":"Checking","balance":1234560,"deleted":false,"note":null}]`)))
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync
+
+import (
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/account"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+// Knowledge represents the server knowledge of each entity of a budget,
+// as of the last pull
+type Knowledge struct {
+	Accounts     uint64 `json:"accounts"`
+	Categories   uint64 `json:"categories"`
+	Payees       uint64 `json:"payees"`
+	Months       uint64 `json:"months"`
+	Transactions uint64 `json:"transactions"`
+}
+
+// Budget represents the merged state of a synced budget. Deleted entities
+// are removed from the state. Entities are keyed by ID, and months by
+// their date formatted as YYYY-MM-DD
+type Budget struct {
+	ID        string
+	Knowledge Knowledge
+
+	Accounts       map[string]*account.Account
+	CategoryGroups map[string]*category.Group
+	Categories     map[string]*category.Category
+	Payees         map[string]*payee.Payee
+	Months         map[string]*month.Summary
+	Transactions   map[string]*transaction.Transaction
+}
+
+// Changes represents the changes of an entity type pulled from the API
+type Changes[T any] struct {
+	// Upserted the created or updated entities
+	Upserted []T
+	// Deleted the IDs of the deleted entities
+	Deleted []string
+}
+
+// Empty reports whether there are no changes
+func (c Changes[T]) Empty() bool {
+	return len(c.Upserted) == 0 && len(c.Deleted) == 0
+}
+
+// ChangeSet represents the changes of a budget pulled from the API
+type ChangeSet struct {
+	BudgetID string
+	// Knowledge the server knowledge after the changes were applied
+	Knowledge Knowledge
+
+	Accounts       Changes[*account.Account]
+	CategoryGroups Changes[*category.Group]
+	Categories     Changes[*category.Category]
+	Payees         Changes[*payee.Payee]
+	Months         Changes[*month.Summary]
+	Transactions   Changes[*transaction.Transaction]
+}
+
+// Empty reports whether the budget did not change since the previous pull
+func (cs *ChangeSet) Empty() bool {
+	return cs.Accounts.Empty() && cs.CategoryGroups.Empty() && cs.Categories.Empty() &&
+		cs.Payees.Empty() && cs.Months.Empty() && cs.Transactions.Empty()
+}
+
+func newBudget(budgetID string) *Budget {
+	return &Budget{
+		ID:             budgetID,
+		Accounts:       make(map[string]*account.Account),
+		CategoryGroups: make(map[string]*category.Group),
+		Categories:     make(map[string]*category.Category),
+		Payees:         make(map[string]*payee.Payee),
+		Months:         make(map[string]*month.Summary),
+		Transactions:   make(map[string]*transaction.Transaction),
+	}
+}
+
+// apply merges a delta into the budget and returns the resulting changes
+func (b *Budget) apply(d *delta) *ChangeSet {
+	groups := make([]*category.Group, 0, len(d.categoryGroups))
+	var categories []*category.Category
+	for _, g := range d.categoryGroups {
+		groups = append(groups, &category.Group{
+			ID:      g.ID,
+			Name:    g.Name,
+			Hidden:  g.Hidden,
+			Deleted: g.Deleted,
+		})
+		categories = append(categories, g.Categories...)
+	}
+
+	b.Knowledge = d.knowledge
+	return &ChangeSet{
+		BudgetID:       b.ID,
+		Knowledge:      d.knowledge,
+		Accounts:       merge(b.Accounts, d.accounts, accountKey, func(a *account.Account) bool { return a.Deleted }),
+		CategoryGroups: merge(b.CategoryGroups, groups, groupKey, func(g *category.Group) bool { return g.Deleted }),
+		Categories:     merge(b.Categories, categories, categoryKey, func(c *category.Category) bool { return c.Deleted }),
+		Payees:         merge(b.Payees, d.payees, payeeKey, func(p *payee.Payee) bool { return p.Deleted }),
+		Months:         merge(b.Months, d.months, monthKey, func(m *month.Summary) bool { return m.Deleted }),
+		Transactions:   merge(b.Transactions, d.transactions, transactionKey, func(t *transaction.Transaction) bool { return t.Deleted }),
+	}
+}
+
+// merge upserts entities into m and removes tombstones from it
+func merge[T any](m map[string]T, entities []T, key func(T) string, deleted func(T) bool) Changes[T] {
+	var c Changes[T]
+	for _, e := range entities {
+		k := key(e)
+		if deleted(e) {
+			delete(m, k)
+			c.Deleted = append(c.Deleted, k)
+			continue
+		}
+		m[k] = e
+		c.Upserted = append(c.Upserted, e)
+	}
+	return c
+}
+
+func accountKey(a *account.Account) string             { return a.ID }
+func groupKey(g *category.Group) string                { return g.ID }
+func categoryKey(c *category.Category) string          { return c.ID }
+func payeeKey(p *payee.Payee) string                   { return p.ID }
+func monthKey(m *month.Summary) string                 { return api.DateFormat(m.Month) }
+func transactionKey(t *transaction.Transaction) string { return t.ID }
+
+// clone returns a copy of the budget whose maps can be used without
+// holding the syncer lock. Entities are shared since merges replace them
+// instead of mutating them
+func (b *Budget) clone() *Budget {
+	return &Budget{
+		ID:             b.ID,
+		Knowledge:      b.Knowledge,
+		Accounts:       cloneMap(b.Accounts),
+		CategoryGroups: cloneMap(b.CategoryGroups),
+		Categories:     cloneMap(b.Categories),
+		Payees:         cloneMap(b.Payees),
+		Months:         cloneMap(b.Months),
+		Transactions:   cloneMap(b.Transactions),
+	}
+}
+
+func cloneMap[T any](m map[string]T) map[string]T {
+	c := make(map[string]T, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/ynabsync"
+)
+
+func ExampleSyncer_Pull() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	s := ynabsync.NewSyncer(c)
+	cs, _ := s.Pull(context.Background(), "<valid_budget_id>")
+	fmt.Println(reflect.TypeOf(cs))
+
+	// Output: *ynabsync.ChangeSet
+}
+
+func ExampleSyncer_Budget() {
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	s := ynabsync.NewSyncer(c)
+	s.Pull(context.Background(), "<valid_budget_id>") //nolint:errcheck
+	b := s.Budget("<valid_budget_id>")
+	fmt.Println(reflect.TypeOf(b))
+
+	// Output: *ynabsync.Budget
+}
+
+func ExamplePersistence() {
+	st, _ := ynabsync.NewFileStore("<valid_directory>")
+	c := ynab.NewClient("<valid_ynab_access_token>")
+	s := ynabsync.NewSyncer(c, ynabsync.Persistence(st))
+	s.Pull(context.Background(), "<valid_budget_id>") //nolint:errcheck
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mellis/ynab.go/api/account"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+// NewFileStore facilitates the creation of a new file store instance
+// keeping its files in dir, which is created if missing
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// FileStore is a Store keeping each budget in a JSON file. Every write
+// replaces the file atomically, so a crash leaves either the previous or
+// the new version of the file
+type FileStore struct {
+	sync.Mutex
+
+	dir string
+}
+
+// budgetFile represents the content of a budget file
+type budgetFile struct {
+	Knowledge Knowledge                             `json:"knowledge"`
+	Entities  map[Entity]map[string]json.RawMessage `json:"entities"`
+}
+
+// LoadKnowledge returns the knowledge of a budget
+func (s *FileStore) LoadKnowledge(ctx context.Context, budgetID string) (Knowledge, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := s.read(budgetID)
+	if err != nil {
+		return Knowledge{}, err
+	}
+	return f.Knowledge, nil
+}
+
+// SaveKnowledge saves the knowledge of a budget
+func (s *FileStore) SaveKnowledge(ctx context.Context, budgetID string, k Knowledge) error {
+	return s.update(budgetID, func(f *budgetFile) {
+		f.Knowledge = k
+	})
+}
+
+// LoadBudget returns the stored state of a budget
+func (s *FileStore) LoadBudget(ctx context.Context, budgetID string) (*Budget, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := s.read(budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make(map[Entity][]record, len(f.Entities))
+	for e, entities := range f.Entities {
+		for key, data := range entities {
+			rs[e] = append(rs[e], record{key: key, data: data})
+		}
+	}
+	return decodeBudget(budgetID, f.Knowledge, rs)
+}
+
+// UpsertAccounts stores accounts
+func (s *FileStore) UpsertAccounts(ctx context.Context, budgetID string, accounts []*account.Account) error {
+	rs, err := records(accounts, accountKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityAccounts, rs)
+}
+
+// UpsertCategoryGroups stores category groups
+func (s *FileStore) UpsertCategoryGroups(ctx context.Context, budgetID string, groups []*category.Group) error {
+	rs, err := records(groups, groupKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityCategoryGroups, rs)
+}
+
+// UpsertCategories stores categories
+func (s *FileStore) UpsertCategories(ctx context.Context, budgetID string, categories []*category.Category) error {
+	rs, err := records(categories, categoryKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityCategories, rs)
+}
+
+// UpsertPayees stores payees
+func (s *FileStore) UpsertPayees(ctx context.Context, budgetID string, payees []*payee.Payee) error {
+	rs, err := records(payees, payeeKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityPayees, rs)
+}
+
+// UpsertMonths stores months
+func (s *FileStore) UpsertMonths(ctx context.Context, budgetID string, months []*month.Summary) error {
+	rs, err := records(months, monthKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityMonths, rs)
+}
+
+// UpsertTransactions stores transactions
+func (s *FileStore) UpsertTransactions(ctx context.Context, budgetID string,
+	transactions []*transaction.Transaction) error {
+
+	rs, err := records(transactions, transactionKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(budgetID, EntityTransactions, rs)
+}
+
+// Delete removes entities from a budget
+func (s *FileStore) Delete(ctx context.Context, budgetID string, e Entity, keys []string) error {
+	return s.update(budgetID, func(f *budgetFile) {
+		for _, key := range keys {
+			delete(f.Entities[e], key)
+		}
+	})
+}
+
+func (s *FileStore) upsert(budgetID string, e Entity, rs []record) error {
+	if len(rs) == 0 {
+		return nil
+	}
+
+	return s.update(budgetID, func(f *budgetFile) {
+		entities, ok := f.Entities[e]
+		if !ok {
+			entities = make(map[string]json.RawMessage, len(rs))
+			f.Entities[e] = entities
+		}
+		for _, r := range rs {
+			entities[r.key] = r.data
+		}
+	})
+}
+
+// update applies fn to the budget file and writes it back
+func (s *FileStore) update(budgetID string, fn func(*budgetFile)) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := s.read(budgetID)
+	if err != nil {
+		return err
+	}
+	fn(f)
+	return s.write(budgetID, f)
+}
+
+func (s *FileStore) path(budgetID string) string {
+	return filepath.Join(s.dir, url.PathEscape(budgetID)+".json")
+}
+
+func (s *FileStore) read(budgetID string) (*budgetFile, error) {
+	f := &budgetFile{}
+	buf, err := os.ReadFile(s.path(budgetID))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(buf, f); err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Entities == nil {
+		f.Entities = make(map[Entity]map[string]json.RawMessage)
+	}
+	return f, nil
+}
+
+// write replaces the budget file atomically: the content is written and
+// synced to a temporary file which is then renamed over the budget file
+func (s *FileStore) write(budgetID string, f *budgetFile) error {
+	buf, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, url.PathEscape(budgetID)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(budgetID))
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package storetest implements the tests shared by the stores of ynabsync,
+// run against each implementation with the delta responses of a budget
+package storetest // import "github.com/mellis/ynab.go/ynabsync/internal/storetest"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api/transaction"
+	"github.com/mellis/ynab.go/ynabsync"
+)
+
+// BudgetID the budget served by DeltaResponses
+const BudgetID = "aa248caa-eed7-4575-a990-717386438d2c"
+
+// BudgetURL the API URL of the budget served by DeltaResponses
+const BudgetURL = "https://api.youneedabudget.com/v1/budgets/" + BudgetID
+
+// RegisterDeltaResponders registers a responder per entity endpoint, which
+// serves the response keyed by the requested last_knowledge_of_server
+func RegisterDeltaResponders(t *testing.T, responses map[string]map[string]string) {
+	for path, bodies := range responses {
+		path, bodies := path, bodies
+		httpmock.RegisterResponder(http.MethodGet, BudgetURL+path,
+			func(req *http.Request) (*http.Response, error) {
+				knowledge := req.URL.Query().Get("last_knowledge_of_server")
+				body, ok := bodies[knowledge]
+				assert.True(t, ok, "unexpected knowledge %q for %s", knowledge, path)
+
+				res := httpmock.NewStringResponse(http.StatusOK, body)
+				res.Header.Add("X-Rate-Limit", "36/200")
+				return res, nil
+			},
+		)
+	}
+}
+
+// DeltaResponses the responses of two consecutive pulls of a budget
+var DeltaResponses = map[string]map[string]string{
+	"/accounts": {
+		"0": `{"data":{"server_knowledge":10,"accounts":[
+			{"id":"acc-1","name":"Checking","type":"checking","balance":100000},
+			{"id":"acc-2","name":"Savings","type":"savings","balance":500000}
+		]}}`,
+		"10": `{"data":{"server_knowledge":12,"accounts":[
+			{"id":"acc-1","name":"Checking","type":"checking","balance":90000},
+			{"id":"acc-2","deleted":true}
+		]}}`,
+	},
+	"/categories": {
+		"0": `{"data":{"server_knowledge":10,"category_groups":[
+			{"id":"grp-1","name":"Bills","categories":[
+				{"id":"cat-1","category_group_id":"grp-1","name":"Rent","budgeted":800000}
+			]}
+		]}}`,
+		"10": `{"data":{"server_knowledge":10,"category_groups":[]}}`,
+	},
+	"/payees": {
+		"0": `{"data":{"server_knowledge":10,"payees":[
+			{"id":"pay-1","name":"Landlord"}
+		]}}`,
+		"10": `{"data":{"server_knowledge":11,"payees":[
+			{"id":"pay-2","name":"Supermarket"}
+		]}}`,
+	},
+	"/months": {
+		"0": `{"data":{"server_knowledge":10,"months":[
+			{"month":"2018-10-01","budgeted":800000},
+			{"month":"2018-11-01","budgeted":0}
+		]}}`,
+		"10": `{"data":{"server_knowledge":12,"months":[
+			{"month":"2018-10-01","budgeted":810000},
+			{"month":"2018-11-01","budgeted":0,"deleted":true}
+		]}}`,
+	},
+	"/transactions": {
+		"": `{"data":{"server_knowledge":10,"transactions":[
+			{"id":"tx-1","date":"2018-10-01","amount":-800000,"account_id":"acc-1"},
+			{"id":"tx-2","date":"2018-10-02","amount":-10000,"account_id":"acc-2"}
+		]}}`,
+		"10": `{"data":{"server_knowledge":12,"transactions":[
+			{"id":"tx-2","date":"2018-10-02","amount":-10000,"account_id":"acc-2","deleted":true},
+			{"id":"tx-3","date":"2018-10-03","amount":-10000,"account_id":"acc-1"}
+		]}}`,
+	},
+}
+
+// Run runs the store tests against the stores returned by newStore, each
+// of which must be empty
+func Run(t *testing.T, newStore func(t *testing.T) ynabsync.Store) {
+	t.Run("restart", func(t *testing.T) { testRestart(t, newStore(t)) })
+	t.Run("crash mid merge", func(t *testing.T) { testCrashMidMerge(t, newStore(t)) })
+}
+
+// failingStore fails to store transactions once armed, simulating a
+// crash in the middle of a merge
+type failingStore struct {
+	ynabsync.Store
+	armed bool
+}
+
+var errCrash = errors.New("crash")
+
+func (s *failingStore) UpsertTransactions(ctx context.Context, budgetID string,
+	transactions []*transaction.Transaction) error {
+
+	if s.armed {
+		return errCrash
+	}
+	return s.Store.UpsertTransactions(ctx, budgetID, transactions)
+}
+
+// testRestart asserts a new syncer resumes from the stored budget
+func testRestart(t *testing.T, st ynabsync.Store) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	RegisterDeltaResponders(t, DeltaResponses)
+
+	s := ynabsync.NewSyncer(ynab.NewClient(""), ynabsync.Persistence(st))
+	_, err := s.Pull(context.Background(), BudgetID)
+	assert.NoError(t, err)
+
+	stored, err := st.LoadBudget(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, s.Budget(BudgetID), stored)
+
+	// a new syncer resumes from the stored knowledge
+	s = ynabsync.NewSyncer(ynab.NewClient(""), ynabsync.Persistence(st))
+	cs, err := s.Pull(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"acc-2"}, cs.Accounts.Deleted)
+
+	b := s.Budget(BudgetID)
+	assert.Len(t, b.Accounts, 1)
+	assert.Len(t, b.Payees, 2)
+	assert.Len(t, b.Transactions, 2)
+	assert.Contains(t, b.Transactions, "tx-3")
+
+	k, err := st.LoadKnowledge(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, cs.Knowledge, k)
+
+	stored, err = st.LoadBudget(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, b, stored)
+}
+
+// testCrashMidMerge asserts a pull failing halfway through storing its
+// changes is pulled again after a restart
+func testCrashMidMerge(t *testing.T, st ynabsync.Store) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	RegisterDeltaResponders(t, DeltaResponses)
+
+	fst := &failingStore{Store: st}
+	s := ynabsync.NewSyncer(ynab.NewClient(""), ynabsync.Persistence(fst))
+	_, err := s.Pull(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	before := s.Budget(BudgetID)
+
+	// accounts, categories, payees and months of the second pull
+	// are stored before the crash, the knowledge is not
+	fst.armed = true
+	_, err = s.Pull(context.Background(), BudgetID)
+	assert.True(t, errors.Is(err, errCrash))
+	assert.Equal(t, before, s.Budget(BudgetID))
+
+	k, err := st.LoadKnowledge(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, before.Knowledge, k)
+
+	// after a restart the changes are pulled again and merged over
+	// the partially stored ones
+	s = ynabsync.NewSyncer(ynab.NewClient(""), ynabsync.Persistence(st))
+	cs, err := s.Pull(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, ynabsync.Knowledge{Accounts: 12, Categories: 10, Payees: 11, Months: 12, Transactions: 12}, cs.Knowledge)
+
+	stored, err := st.LoadBudget(context.Background(), BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, s.Budget(BudgetID), stored)
+	assert.Len(t, stored.Accounts, 1)
+	assert.Len(t, stored.Transactions, 2)
+	assert.NotContains(t, stored.Transactions, "tx-2")
+}
@@ -0,0 +1,8 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package sqlitetest runs the store tests of ynabsync against the SQL store
+// backed by SQLite. It is a module of its own, so SQLite is not a
+// requirement of the client
+package sqlitetest // import "github.com/mellis/ynab.go/ynabsync/sqlitetest"
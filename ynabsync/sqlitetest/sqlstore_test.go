@@ -0,0 +1,33 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package sqlitetest_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+
+	"github.com/mellis/ynab.go/ynabsync"
+	"github.com/mellis/ynab.go/ynabsync/internal/storetest"
+)
+
+// open returns a SQL store over a new SQLite database
+func open(t *testing.T) *ynabsync.SQLStore {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "ynab.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	st, err := ynabsync.NewSQLStore(context.Background(), db)
+	assert.NoError(t, err)
+	return st
+}
+
+func TestSQLStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) ynabsync.Store { return open(t) })
+}
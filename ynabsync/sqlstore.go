@@ -0,0 +1,238 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/mellis/ynab.go/api/account"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+// sqlSchema the tables kept by a SQL store
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS ynab_knowledge (
+		budget_id    TEXT    NOT NULL PRIMARY KEY,
+		accounts     INTEGER NOT NULL,
+		categories   INTEGER NOT NULL,
+		payees       INTEGER NOT NULL,
+		months       INTEGER NOT NULL,
+		transactions INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS ynab_entities (
+		budget_id TEXT NOT NULL,
+		entity    TEXT NOT NULL,
+		id        TEXT NOT NULL,
+		data      TEXT NOT NULL,
+		PRIMARY KEY (budget_id, entity, id)
+	)`,
+}
+
+// NewSQLStore facilitates the creation of a new SQL store instance,
+// creating its tables when missing. Statements use ? placeholders and
+// portable types, which suits embedded databases such as SQLite
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	for _, stmt := range sqlSchema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// SQLStore is a Store keeping budgets in a SQL database. Every write runs
+// in its own database transaction
+type SQLStore struct {
+	db *sql.DB
+}
+
+// LoadKnowledge returns the knowledge of a budget
+func (s *SQLStore) LoadKnowledge(ctx context.Context, budgetID string) (Knowledge, error) {
+	return s.loadKnowledge(ctx, s.db, budgetID)
+}
+
+// SaveKnowledge saves the knowledge of a budget
+func (s *SQLStore) SaveKnowledge(ctx context.Context, budgetID string, k Knowledge) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM ynab_knowledge WHERE budget_id = ?`, budgetID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO ynab_knowledge (budget_id, accounts, categories, payees, months, transactions)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			budgetID, k.Accounts, k.Categories, k.Payees, k.Months, k.Transactions)
+		return err
+	})
+}
+
+// LoadBudget returns the stored state of a budget
+func (s *SQLStore) LoadBudget(ctx context.Context, budgetID string) (*Budget, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	k, err := s.loadKnowledge(ctx, tx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT entity, id, data FROM ynab_entities WHERE budget_id = ?`, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rs := make(map[Entity][]record)
+	for rows.Next() {
+		var (
+			e    Entity
+			r    record
+			data string
+		)
+		if err := rows.Scan(&e, &r.key, &data); err != nil {
+			return nil, err
+		}
+		r.data = []byte(data)
+		rs[e] = append(rs[e], r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return decodeBudget(budgetID, k, rs)
+}
+
+// UpsertAccounts stores accounts
+func (s *SQLStore) UpsertAccounts(ctx context.Context, budgetID string, accounts []*account.Account) error {
+	rs, err := records(accounts, accountKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityAccounts, rs)
+}
+
+// UpsertCategoryGroups stores category groups
+func (s *SQLStore) UpsertCategoryGroups(ctx context.Context, budgetID string, groups []*category.Group) error {
+	rs, err := records(groups, groupKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityCategoryGroups, rs)
+}
+
+// UpsertCategories stores categories
+func (s *SQLStore) UpsertCategories(ctx context.Context, budgetID string, categories []*category.Category) error {
+	rs, err := records(categories, categoryKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityCategories, rs)
+}
+
+// UpsertPayees stores payees
+func (s *SQLStore) UpsertPayees(ctx context.Context, budgetID string, payees []*payee.Payee) error {
+	rs, err := records(payees, payeeKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityPayees, rs)
+}
+
+// UpsertMonths stores months
+func (s *SQLStore) UpsertMonths(ctx context.Context, budgetID string, months []*month.Summary) error {
+	rs, err := records(months, monthKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityMonths, rs)
+}
+
+// UpsertTransactions stores transactions
+func (s *SQLStore) UpsertTransactions(ctx context.Context, budgetID string,
+	transactions []*transaction.Transaction) error {
+
+	rs, err := records(transactions, transactionKey)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, budgetID, EntityTransactions, rs)
+}
+
+// Delete removes entities from a budget
+func (s *SQLStore) Delete(ctx context.Context, budgetID string, e Entity, keys []string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, key := range keys {
+			if _, err := tx.ExecContext(ctx,
+				`DELETE FROM ynab_entities WHERE budget_id = ? AND entity = ? AND id = ?`,
+				budgetID, string(e), key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// upsert replaces records, deleting then inserting them so it does not
+// depend on a dialect specific upsert statement
+func (s *SQLStore) upsert(ctx context.Context, budgetID string, e Entity, rs []record) error {
+	if len(rs) == 0 {
+		return nil
+	}
+
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, r := range rs {
+			if _, err := tx.ExecContext(ctx,
+				`DELETE FROM ynab_entities WHERE budget_id = ? AND entity = ? AND id = ?`,
+				budgetID, string(e), r.key); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO ynab_entities (budget_id, entity, id, data) VALUES (?, ?, ?, ?)`,
+				budgetID, string(e), r.key, string(r.data)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (s *SQLStore) loadKnowledge(ctx context.Context, q querier, budgetID string) (Knowledge, error) {
+	var k Knowledge
+	err := q.QueryRowContext(ctx,
+		`SELECT accounts, categories, payees, months, transactions
+		FROM ynab_knowledge WHERE budget_id = ?`, budgetID).
+		Scan(&k.Accounts, &k.Categories, &k.Payees, &k.Months, &k.Transactions)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Knowledge{}, nil
+	}
+	return k, err
+}
+
+// inTx runs fn in a database transaction, committed only when fn succeeds
+func (s *SQLStore) inTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback() //nolint:errcheck
+		return err
+	}
+	return tx.Commit()
+}
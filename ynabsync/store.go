@@ -0,0 +1,157 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mellis/ynab.go/api/account"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+// Entity identifies a type of budget entity kept by a Store
+type Entity string
+
+const (
+	// EntityAccounts identifies accounts
+	EntityAccounts Entity = "accounts"
+	// EntityCategoryGroups identifies category groups
+	EntityCategoryGroups Entity = "category_groups"
+	// EntityCategories identifies categories
+	EntityCategories Entity = "categories"
+	// EntityPayees identifies payees
+	EntityPayees Entity = "payees"
+	// EntityMonths identifies months, keyed by their date
+	EntityMonths Entity = "months"
+	// EntityTransactions identifies transactions
+	EntityTransactions Entity = "transactions"
+)
+
+// Store contract for a persistent storage of synced budgets
+//
+// A Syncer saves the knowledge of a pull only after every change of that
+// pull was stored. Should a merge be interrupted, the next pull requests
+// the same changes again and upserts them over the partially stored ones,
+// so implementations only need each method call to be atomic.
+type Store interface {
+	// LoadKnowledge returns the knowledge of a budget, which is zero for
+	// a budget never stored
+	LoadKnowledge(ctx context.Context, budgetID string) (Knowledge, error)
+	// SaveKnowledge saves the knowledge of a budget
+	SaveKnowledge(ctx context.Context, budgetID string, k Knowledge) error
+	// LoadBudget returns the stored state of a budget, which is empty for
+	// a budget never stored
+	LoadBudget(ctx context.Context, budgetID string) (*Budget, error)
+
+	UpsertAccounts(ctx context.Context, budgetID string, accounts []*account.Account) error
+	UpsertCategoryGroups(ctx context.Context, budgetID string, groups []*category.Group) error
+	UpsertCategories(ctx context.Context, budgetID string, categories []*category.Category) error
+	UpsertPayees(ctx context.Context, budgetID string, payees []*payee.Payee) error
+	UpsertMonths(ctx context.Context, budgetID string, months []*month.Summary) error
+	UpsertTransactions(ctx context.Context, budgetID string, transactions []*transaction.Transaction) error
+
+	// Delete removes the entities of the given type and keys from a budget
+	Delete(ctx context.Context, budgetID string, e Entity, keys []string) error
+}
+
+// record represents a stored entity, encoded as JSON
+type record struct {
+	key  string
+	data []byte
+}
+
+// records encodes entities as records
+func records[T any](entities []T, key func(T) string) ([]record, error) {
+	rs := make([]record, 0, len(entities))
+	for _, e := range entities {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, record{key: key(e), data: data})
+	}
+	return rs, nil
+}
+
+// decodeRecords decodes stored entities into the map of a budget
+func decodeRecords[T any](m map[string]*T, rs []record) error {
+	for _, r := range rs {
+		var e T
+		if err := json.Unmarshal(r.data, &e); err != nil {
+			return err
+		}
+		m[r.key] = &e
+	}
+	return nil
+}
+
+// decodeBudget builds a budget from its stored records
+func decodeBudget(budgetID string, k Knowledge, rs map[Entity][]record) (*Budget, error) {
+	b := newBudget(budgetID)
+	b.Knowledge = k
+
+	if err := decodeRecords(b.Accounts, rs[EntityAccounts]); err != nil {
+		return nil, err
+	}
+	if err := decodeRecords(b.CategoryGroups, rs[EntityCategoryGroups]); err != nil {
+		return nil, err
+	}
+	if err := decodeRecords(b.Categories, rs[EntityCategories]); err != nil {
+		return nil, err
+	}
+	if err := decodeRecords(b.Payees, rs[EntityPayees]); err != nil {
+		return nil, err
+	}
+	if err := decodeRecords(b.Months, rs[EntityMonths]); err != nil {
+		return nil, err
+	}
+	if err := decodeRecords(b.Transactions, rs[EntityTransactions]); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// persist stores a change set, saving its knowledge last
+func persist(ctx context.Context, st Store, cs *ChangeSet) error {
+	upserts := []func() error{
+		func() error { return st.UpsertAccounts(ctx, cs.BudgetID, cs.Accounts.Upserted) },
+		func() error { return st.UpsertCategoryGroups(ctx, cs.BudgetID, cs.CategoryGroups.Upserted) },
+		func() error { return st.UpsertCategories(ctx, cs.BudgetID, cs.Categories.Upserted) },
+		func() error { return st.UpsertPayees(ctx, cs.BudgetID, cs.Payees.Upserted) },
+		func() error { return st.UpsertMonths(ctx, cs.BudgetID, cs.Months.Upserted) },
+		func() error { return st.UpsertTransactions(ctx, cs.BudgetID, cs.Transactions.Upserted) },
+	}
+	for _, upsert := range upserts {
+		if err := upsert(); err != nil {
+			return err
+		}
+	}
+
+	deletes := []struct {
+		entity Entity
+		keys   []string
+	}{
+		{EntityAccounts, cs.Accounts.Deleted},
+		{EntityCategoryGroups, cs.CategoryGroups.Deleted},
+		{EntityCategories, cs.Categories.Deleted},
+		{EntityPayees, cs.Payees.Deleted},
+		{EntityMonths, cs.Months.Deleted},
+		{EntityTransactions, cs.Transactions.Deleted},
+	}
+	for _, d := range deletes {
+		if len(d.keys) == 0 {
+			continue
+		}
+		if err := st.Delete(ctx, cs.BudgetID, d.entity, d.keys); err != nil {
+			return err
+		}
+	}
+
+	return st.SaveKnowledge(ctx, cs.BudgetID, cs.Knowledge)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mellis/ynab.go/ynabsync"
+	"github.com/mellis/ynab.go/ynabsync/internal/storetest"
+)
+
+func TestFileStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) ynabsync.Store {
+		st, err := ynabsync.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+		return st
+	})
+}
+
+func TestFileStore_atomicWrite(t *testing.T) {
+	dir := t.TempDir()
+	st, err := ynabsync.NewFileStore(dir)
+	assert.NoError(t, err)
+
+	k := ynabsync.Knowledge{Accounts: 10, Transactions: 12}
+	assert.NoError(t, st.SaveKnowledge(context.Background(), storetest.BudgetID, k))
+
+	// a temporary file left over by a write interrupted before the rename
+	// does not affect the stored budget
+	stray := filepath.Join(dir, storetest.BudgetID+".123.tmp")
+	assert.NoError(t, os.WriteFile(stray, []byte(`{"knowledge":{"acc`), 0o600))
+
+	loaded, err := st.LoadKnowledge(context.Background(), storetest.BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, k, loaded)
+
+	k.Payees = 11
+	assert.NoError(t, st.SaveKnowledge(context.Background(), storetest.BudgetID, k))
+	loaded, err = st.LoadKnowledge(context.Background(), storetest.BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, k, loaded)
+
+	// successful writes leave no temporary file behind
+	matches, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{stray}, matches)
+}
+
+func TestFileStore_empty(t *testing.T) {
+	st, err := ynabsync.NewFileStore(t.TempDir())
+	assert.NoError(t, err)
+
+	k, err := st.LoadKnowledge(context.Background(), storetest.BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, ynabsync.Knowledge{}, k)
+
+	b, err := st.LoadBudget(context.Background(), storetest.BudgetID)
+	assert.NoError(t, err)
+	assert.Equal(t, storetest.BudgetID, b.ID)
+	assert.Empty(t, b.Accounts)
+}
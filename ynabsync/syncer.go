@@ -0,0 +1,161 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+// Package ynabsync implements incremental synchronisation of budgets, built on
+// the server knowledge returned by the API delta requests
+package ynabsync // import "github.com/mellis/ynab.go/ynabsync"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/api/account"
+	"github.com/mellis/ynab.go/api/category"
+	"github.com/mellis/ynab.go/api/month"
+	"github.com/mellis/ynab.go/api/payee"
+	"github.com/mellis/ynab.go/api/transaction"
+)
+
+// NewSyncer facilitates the creation of a new syncer instance
+func NewSyncer(c ynab.ClientServicer, options ...func(*Syncer)) *Syncer {
+	s := &Syncer{
+		c:       c,
+		budgets: make(map[string]*Budget),
+	}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Persistence configures the syncer to keep budgets in a store, so a
+// syncer created after a restart resumes from the stored knowledge
+func Persistence(st Store) func(*Syncer) {
+	return func(s *Syncer) {
+		s.store = st
+	}
+}
+
+// Syncer keeps an in-memory copy of budgets up to date by pulling only
+// the entities that changed since the previous pull
+type Syncer struct {
+	sync.Mutex
+
+	c       ynab.ClientServicer
+	store   Store
+	budgets map[string]*Budget
+}
+
+// Budget returns a copy of the merged state of a budget, or nil when the
+// budget was never pulled
+func (s *Syncer) Budget(budgetID string) *Budget {
+	s.Lock()
+	defer s.Unlock()
+
+	b, ok := s.budgets[budgetID]
+	if !ok {
+		return nil
+	}
+	return b.clone()
+}
+
+// Pull fetches the changes of every entity of a budget since the previous
+// pull and merges them into the budget state. The state is only updated
+// once every entity was fetched successfully and, when persistence is
+// configured, stored
+func (s *Syncer) Pull(ctx context.Context, budgetID string) (*ChangeSet, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	b, err := s.budget(ctx, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.fetch(ctx, budgetID, b.Knowledge)
+	if err != nil {
+		return nil, err
+	}
+
+	next := b.clone()
+	cs := next.apply(d)
+	if s.store != nil {
+		if err := persist(ctx, s.store, cs); err != nil {
+			return nil, err
+		}
+	}
+
+	s.budgets[budgetID] = next
+	return cs, nil
+}
+
+// budget returns the current state of a budget, loading it from the
+// store when not yet in memory
+func (s *Syncer) budget(ctx context.Context, budgetID string) (*Budget, error) {
+	if b, ok := s.budgets[budgetID]; ok {
+		return b, nil
+	}
+	if s.store == nil {
+		return newBudget(budgetID), nil
+	}
+	return s.store.LoadBudget(ctx, budgetID)
+}
+
+// delta holds the entities fetched by a pull
+type delta struct {
+	knowledge      Knowledge
+	accounts       []*account.Account
+	categoryGroups []*category.GroupWithCategories
+	payees         []*payee.Payee
+	months         []*month.Summary
+	transactions   []*transaction.Transaction
+}
+
+func (s *Syncer) fetch(ctx context.Context, budgetID string, k Knowledge) (*delta, error) {
+	accounts, err := s.c.Account().GetAccounts(ctx, budgetID, &api.Filter{LastKnowledgeOfServer: k.Accounts})
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.c.Category().GetCategories(ctx, budgetID, &api.Filter{LastKnowledgeOfServer: k.Categories})
+	if err != nil {
+		return nil, err
+	}
+
+	payees, err := s.c.Payee().GetPayees(ctx, budgetID, &api.Filter{LastKnowledgeOfServer: k.Payees})
+	if err != nil {
+		return nil, err
+	}
+
+	months, err := s.c.Month().GetMonths(ctx, budgetID, &api.Filter{LastKnowledgeOfServer: k.Months})
+	if err != nil {
+		return nil, err
+	}
+
+	var f *transaction.Filter
+	if k.Transactions > 0 {
+		f = &transaction.Filter{LastKnowledgeOfServer: &k.Transactions}
+	}
+	transactions, transactionsKnowledge, err := s.c.Transaction().GetTransactions(ctx, budgetID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delta{
+		knowledge: Knowledge{
+			Accounts:     accounts.ServerKnowledge,
+			Categories:   categories.ServerKnowledge,
+			Payees:       payees.ServerKnowledge,
+			Months:       months.ServerKnowledge,
+			Transactions: transactionsKnowledge,
+		},
+		accounts:       accounts.Accounts,
+		categoryGroups: categories.GroupWithCategories,
+		payees:         payees.Payees,
+		months:         months.Months,
+		transactions:   transactions,
+	}, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2018, Bruno M V Souza <github@b.bmvs.io>. All rights reserved.
+// Use of this source code is governed by a BSD-2-Clause license that can be
+// found in the LICENSE file.
+
+package ynabsync_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/mellis/ynab.go"
+	"github.com/mellis/ynab.go/api"
+	"github.com/mellis/ynab.go/ynabsync"
+	"github.com/mellis/ynab.go/ynabsync/internal/storetest"
+)
+
+func TestSyncer_Pull(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	storetest.RegisterDeltaResponders(t, storetest.DeltaResponses)
+
+	s := ynabsync.NewSyncer(ynab.NewClient(""))
+	assert.Nil(t, s.Budget("aa248caa-eed7-4575-a990-717386438d2c"))
+
+	cs, err := s.Pull(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+	assert.False(t, cs.Empty())
+	assert.Equal(t, ynabsync.Knowledge{Accounts: 10, Categories: 10, Payees: 10, Months: 10, Transactions: 10}, cs.Knowledge)
+	assert.Len(t, cs.Accounts.Upserted, 2)
+	assert.Len(t, cs.Transactions.Upserted, 2)
+	assert.Empty(t, cs.Transactions.Deleted)
+
+	b := s.Budget("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.Len(t, b.Accounts, 2)
+	assert.Equal(t, "Bills", b.CategoryGroups["grp-1"].Name)
+	assert.Equal(t, "Rent", b.Categories["cat-1"].Name)
+	assert.Len(t, b.Transactions, 2)
+
+	cs, err = s.Pull(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c")
+	assert.NoError(t, err)
+	assert.Equal(t, ynabsync.Knowledge{Accounts: 12, Categories: 10, Payees: 11, Months: 12, Transactions: 12}, cs.Knowledge)
+	assert.Equal(t, []string{"acc-2"}, cs.Accounts.Deleted)
+	assert.Equal(t, "acc-1", cs.Accounts.Upserted[0].ID)
+	assert.True(t, cs.Categories.Empty())
+	assert.True(t, cs.CategoryGroups.Empty())
+	assert.Equal(t, "pay-2", cs.Payees.Upserted[0].ID)
+	assert.Equal(t, []string{"2018-11-01"}, cs.Months.Deleted)
+	assert.Len(t, cs.Months.Upserted, 1)
+	assert.Equal(t, []string{"tx-2"}, cs.Transactions.Deleted)
+	assert.Equal(t, "tx-3", cs.Transactions.Upserted[0].ID)
+
+	// the budget previously returned is not affected by the pull
+	assert.Len(t, b.Accounts, 2)
+
+	b = s.Budget("aa248caa-eed7-4575-a990-717386438d2c")
+	assert.Equal(t, cs.Knowledge, b.Knowledge)
+	assert.Len(t, b.Accounts, 1)
+	assert.Equal(t, int64(90000), b.Accounts["acc-1"].Balance)
+	assert.Len(t, b.Payees, 2)
+	assert.Len(t, b.Months, 1)
+	assert.Equal(t, int64(810000), *b.Months["2018-10-01"].Budgeted)
+	assert.Len(t, b.Transactions, 2)
+	assert.Contains(t, b.Transactions, "tx-1")
+	assert.Contains(t, b.Transactions, "tx-3")
+}
+
+func TestSyncer_Pull_failure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	storetest.RegisterDeltaResponders(t, map[string]map[string]string{
+		"/accounts": {
+			"0": `{"data":{"server_knowledge":10,"accounts":[{"id":"acc-1","name":"Checking"}]}}`,
+		},
+	})
+	httpmock.RegisterResponder(http.MethodGet, storetest.BudgetURL+"/categories",
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, ""))
+
+	s := ynabsync.NewSyncer(ynab.NewClient(""))
+	_, err := s.Pull(context.Background(), "aa248caa-eed7-4575-a990-717386438d2c")
+	assert.True(t, errors.Is(err, api.ErrUnavailable))
+	assert.Nil(t, s.Budget("aa248caa-eed7-4575-a990-717386438d2c"))
+}